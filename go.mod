@@ -1,6 +1,6 @@
 module github.com/matigumma/mem0-go-client
 
-go 1.21
+go 1.23
 
 require (
 	github.com/aws/smithy-go v1.22.1
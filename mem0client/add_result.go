@@ -0,0 +1,137 @@
+package mem0client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Output formats supported by the add and search endpoints
+const (
+	OutputFormatV10 = "v1.0"
+	OutputFormatV11 = "v1.1"
+)
+
+// AddEvent describes what the extraction pipeline did to a single memory
+type AddEvent struct {
+	ID             string      `json:"id"`
+	Memory         string      `json:"memory"`
+	Event          MemoryEvent `json:"event"`
+	PreviousMemory *string     `json:"previous_memory,omitempty"`
+}
+
+// UnmarshalJSON accepts both the flat v1.1 event shape and the v1.0 shape
+// where the memory text is nested under "data"
+func (e *AddEvent) UnmarshalJSON(data []byte) error {
+	var wire struct {
+		ID             string      `json:"id"`
+		Memory         string      `json:"memory"`
+		Event          MemoryEvent `json:"event"`
+		PreviousMemory *string     `json:"previous_memory"`
+		OldMemory      *string     `json:"old_memory"`
+		Data           *struct {
+			Memory    string  `json:"memory"`
+			OldMemory *string `json:"old_memory"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+
+	e.ID = wire.ID
+	e.Memory = wire.Memory
+	e.Event = wire.Event
+	e.PreviousMemory = wire.PreviousMemory
+	if e.PreviousMemory == nil {
+		e.PreviousMemory = wire.OldMemory
+	}
+	if wire.Data != nil {
+		if e.Memory == "" {
+			e.Memory = wire.Data.Memory
+		}
+		if e.PreviousMemory == nil {
+			e.PreviousMemory = wire.Data.OldMemory
+		}
+	}
+	return nil
+}
+
+// AddResult is the outcome of a Store call
+type AddResult struct {
+	// Format is the output format the response was decoded with
+	Format string
+	Events []AddEvent
+	// Relations and DeletedRelations hold the graph relations added and removed
+	// when graph memory is enabled
+	Relations        []Relation
+	DeletedRelations []Relation
+	// EventID and Status are set instead of Events when the add was queued with AsyncMode
+	EventID string
+	Status  EventStatus
+}
+
+// ByEvent returns the events of the given kind
+func (r *AddResult) ByEvent(kind MemoryEvent) []AddEvent {
+	var events []AddEvent
+	for _, e := range r.Events {
+		if e.Event == kind {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+// decodeAddResult decodes an add response. v1.0 responses are a bare list of
+// events while v1.1 responses wrap them in {"results": [...], "relations": ...}.
+func decodeAddResult(raw json.RawMessage) (*AddResult, error) {
+	result := &AddResult{}
+
+	if queued, ok := decodeQueuedAdd(raw); ok {
+		result.EventID = queued.EventID
+		result.Status = queued.Status
+		return result, nil
+	}
+
+	var v11Response struct {
+		Results   []AddEvent      `json:"results"`
+		Relations json.RawMessage `json:"relations,omitempty"`
+	}
+	if err := json.Unmarshal(raw, &v11Response); err == nil {
+		added, deleted, err := decodeAddRelations(v11Response.Relations)
+		if err != nil {
+			return nil, err
+		}
+		result.Format = OutputFormatV11
+		result.Events = v11Response.Results
+		result.Relations = added
+		result.DeletedRelations = deleted
+		return result, nil
+	}
+
+	if err := json.Unmarshal(raw, &result.Events); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %v. Raw response: %s", err, string(raw))
+	}
+	result.Format = OutputFormatV10
+	return result, nil
+}
+
+type queuedAdd struct {
+	EventID string      `json:"event_id"`
+	Status  EventStatus `json:"status"`
+	Message string      `json:"message"`
+}
+
+// decodeQueuedAdd detects the response of an async add, which is either a single
+// object or a one element list carrying the event ID
+func decodeQueuedAdd(raw json.RawMessage) (queuedAdd, bool) {
+	var queued queuedAdd
+	if err := json.Unmarshal(raw, &queued); err == nil && queued.EventID != "" {
+		return queued, true
+	}
+
+	var list []queuedAdd
+	if err := json.Unmarshal(raw, &list); err == nil && len(list) == 1 && list[0].EventID != "" {
+		return list[0], true
+	}
+
+	return queuedAdd{}, false
+}
@@ -0,0 +1,105 @@
+package mem0client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestStoreDecodesAddResponses(t *testing.T) {
+	previous := "Likes tea"
+
+	tests := []struct {
+		name   string
+		format string
+		body   string
+		want   *AddResult
+	}{
+		{"v1.0 list with data", OutputFormatV10, `[
+			{"id": "mem-1", "data": {"memory": "Likes coffee", "old_memory": "Likes tea"}, "event": "UPDATE"},
+			{"id": "mem-2", "data": {"memory": "Lives in Lisbon"}, "event": "ADD"}
+		]`, &AddResult{
+			Format: OutputFormatV10,
+			Events: []AddEvent{
+				{ID: "mem-1", Memory: "Likes coffee", Event: MemoryEventUpdate, PreviousMemory: &previous},
+				{ID: "mem-2", Memory: "Lives in Lisbon", Event: MemoryEventAdd},
+			},
+		}},
+		{"v1.1 results and relations", OutputFormatV11, `{
+			"results": [
+				{"id": "mem-1", "memory": "Likes coffee", "event": "UPDATE", "previous_memory": "Likes tea"},
+				{"id": "mem-3", "memory": "Allergic to nuts", "event": "DELETE"}
+			],
+			"relations": {
+				"added_entities": [[{"source": "alex", "relationship": "likes", "destination": "coffee"}]],
+				"deleted_entities": [[{"source": "alex", "relationship": "likes", "target": "tea"}]]
+			}
+		}`, &AddResult{
+			Format: OutputFormatV11,
+			Events: []AddEvent{
+				{ID: "mem-1", Memory: "Likes coffee", Event: MemoryEventUpdate, PreviousMemory: &previous},
+				{ID: "mem-3", Memory: "Allergic to nuts", Event: MemoryEventDelete},
+			},
+			Relations:        []Relation{{Source: "alex", Relationship: "likes", Target: "coffee"}},
+			DeletedRelations: []Relation{{Source: "alex", Relationship: "likes", Target: "tea"}},
+		}},
+		{"v1.1 without relations", OutputFormatV11, `{"results": []}`, &AddResult{
+			Format: OutputFormatV11,
+			Events: []AddEvent{},
+		}},
+		{"async object", OutputFormatV11, `{"message": "Memory processing has been queued", "status": "PENDING", "event_id": "evt-1"}`, &AddResult{
+			EventID: "evt-1",
+			Status:  EventStatusPending,
+		}},
+		{"async one element list", OutputFormatV10, `[{"message": "Memory processing has been queued", "status": "PENDING", "event_id": "evt-2"}]`, &AddResult{
+			EventID: "evt-2",
+			Status:  EventStatusPending,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotFormat string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var payload struct {
+					OutputFormat string `json:"output_format"`
+				}
+				json.NewDecoder(r.Body).Decode(&payload)
+				gotFormat = payload.OutputFormat
+				w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+			c := NewMem0Client("test-key", WithBaseURL(srv.URL))
+
+			format := tt.format
+			got, err := c.Store(context.Background(), &StoreOptions{
+				UserID:       "alex",
+				Messages:     []Message{{Role: "user", Content: "I switched from tea to coffee"}},
+				OutputFormat: &format,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gotFormat != tt.format {
+				t.Errorf("output_format = %q, want %q", gotFormat, tt.format)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Store() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStoreRejectsUndecodableResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`"queued"`))
+	}))
+	defer srv.Close()
+	c := NewMem0Client("test-key", WithBaseURL(srv.URL))
+
+	if _, err := c.Store(context.Background(), &StoreOptions{UserID: "alex", Messages: []Message{{Role: "user", Content: "hi"}}}); err == nil {
+		t.Error("Store() accepted a response that is neither a list nor an object")
+	}
+}
@@ -0,0 +1,219 @@
+package mem0client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+)
+
+// MaxBatchSize is the maximum number of memories the API accepts in a single batch request
+const MaxBatchSize = 1000
+
+// BatchUpdateItem is a single memory update inside a batch
+type BatchUpdateItem struct {
+	MemoryID string `json:"memory_id"`
+	Text     string `json:"text"`
+}
+
+type batchDeleteItem struct {
+	MemoryID string `json:"memory_id"`
+}
+
+// batchResponse is the body of a batch request. The API reports per-memory outcomes
+// in "results" or lists the failed memories in "failed"; a body with neither, such
+// as {"message": "..."}, means every memory in the chunk succeeded.
+type batchResponse struct {
+	Results []batchItemOutcome `json:"results"`
+	Failed  []batchItemOutcome `json:"failed"`
+}
+
+type batchItemOutcome struct {
+	MemoryID string `json:"memory_id"`
+	Status   string `json:"status"`
+	Error    string `json:"error"`
+}
+
+// err returns the error of a failed item, or nil when it succeeded. Items listed
+// as failed get an error even without a status or message.
+func (o batchItemOutcome) err(listedAsFailed bool) error {
+	status := strings.ToLower(o.Status)
+	switch {
+	case status == "not_found" || status == "not found":
+		return &MemoryNotFoundError{MemoryID: o.MemoryID}
+	case o.Error != "":
+		return fmt.Errorf("memory %s: %s", o.MemoryID, o.Error)
+	case listedAsFailed || status == "error" || status == "failed" || status == "failure":
+		return fmt.Errorf("memory %s: batch operation failed", o.MemoryID)
+	}
+	return nil
+}
+
+// itemErrors returns the errors of the failed items by memory ID
+func (r *batchResponse) itemErrors() map[string]error {
+	errs := map[string]error{}
+	for _, o := range r.Results {
+		if err := o.err(false); err != nil && o.MemoryID != "" {
+			errs[o.MemoryID] = err
+		}
+	}
+	for _, o := range r.Failed {
+		if o.MemoryID != "" {
+			errs[o.MemoryID] = o.err(true)
+		}
+	}
+	return errs
+}
+
+// BatchResult reports the outcome of a batch operation for a single memory ID
+type BatchResult struct {
+	MemoryID string
+	Err      error
+}
+
+// BatchResults is the per-ID outcome of a batch operation, in input order
+type BatchResults []BatchResult
+
+// Failed returns the results whose operation did not succeed
+func (r BatchResults) Failed() BatchResults {
+	var failed BatchResults
+	for _, res := range r {
+		if res.Err != nil {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+// FailedIDs returns the memory IDs whose operation did not succeed, ready to be retried
+func (r BatchResults) FailedIDs() []string {
+	var ids []string
+	for _, res := range r {
+		if res.Err != nil {
+			ids = append(ids, res.MemoryID)
+		}
+	}
+	return ids
+}
+
+// BatchUpdate updates many memories, sending up to MaxBatchSize per request.
+// A failing chunk does not stop the remaining chunks; check the per-ID results.
+func (c *Mem0Client) BatchUpdate(ctx context.Context, items []BatchUpdateItem) (BatchResults, error) {
+	return runOperation(c, ctx, OpBatchUpdate, items, c.batchUpdate)
+}
+
+func (c *Mem0Client) batchUpdate(ctx context.Context, items []BatchUpdateItem) (BatchResults, error) {
+	c.log(ctx, slog.LevelDebug, "mem0 batch update", slog.Int("count", len(items)))
+
+	if len(items) == 0 {
+		return nil, fmt.Errorf("at least one memory is required")
+	}
+
+	results := make(BatchResults, len(items))
+	valid := make([]int, 0, len(items))
+	for i, item := range items {
+		results[i].MemoryID = item.MemoryID
+		switch {
+		case item.MemoryID == "":
+			results[i].Err = fmt.Errorf("memory id is required")
+		case item.Text == "":
+			results[i].Err = fmt.Errorf("text is required for updating a memory")
+		default:
+			valid = append(valid, i)
+		}
+	}
+
+	c.runBatches(ctx, valid, results, func(chunk []int) (json.RawMessage, error) {
+		payload := struct {
+			Memories []BatchUpdateItem `json:"memories"`
+		}{Memories: make([]BatchUpdateItem, len(chunk))}
+		for j, idx := range chunk {
+			payload.Memories[j] = items[idx]
+		}
+		var raw json.RawMessage
+		_, err := c.doJSON(ctx, "PUT", c.scopedURL(ctx, c.config.BaseURL+"/batch/"), payload, &raw)
+		return raw, err
+	})
+
+	c.log(ctx, slog.LevelDebug, "mem0 batch update finished", slog.Int("failures", len(results.Failed())))
+	return results, nil
+}
+
+// BatchDelete deletes many memories, sending up to MaxBatchSize per request.
+// A failing chunk does not stop the remaining chunks; check the per-ID results.
+func (c *Mem0Client) BatchDelete(ctx context.Context, memoryIDs []string) (BatchResults, error) {
+	return runOperation(c, ctx, OpBatchDelete, memoryIDs, c.batchDelete)
+}
+
+func (c *Mem0Client) batchDelete(ctx context.Context, memoryIDs []string) (BatchResults, error) {
+	c.log(ctx, slog.LevelDebug, "mem0 batch delete", slog.Int("count", len(memoryIDs)))
+
+	if len(memoryIDs) == 0 {
+		return nil, fmt.Errorf("at least one memory id is required")
+	}
+
+	results := make(BatchResults, len(memoryIDs))
+	valid := make([]int, 0, len(memoryIDs))
+	for i, id := range memoryIDs {
+		results[i].MemoryID = id
+		if id == "" {
+			results[i].Err = fmt.Errorf("memory id is required")
+			continue
+		}
+		valid = append(valid, i)
+	}
+
+	c.runBatches(ctx, valid, results, func(chunk []int) (json.RawMessage, error) {
+		payload := struct {
+			MemoryIDs []batchDeleteItem `json:"memory_ids"`
+		}{MemoryIDs: make([]batchDeleteItem, len(chunk))}
+		for j, idx := range chunk {
+			payload.MemoryIDs[j] = batchDeleteItem{MemoryID: memoryIDs[idx]}
+		}
+		var raw json.RawMessage
+		_, err := c.doJSON(ctx, "DELETE", c.scopedURL(ctx, c.config.BaseURL+"/batch/"), payload, &raw)
+		return raw, err
+	})
+
+	c.log(ctx, slog.LevelDebug, "mem0 batch delete finished", slog.Int("failures", len(results.Failed())))
+	return results, nil
+}
+
+// runBatches splits the result indexes into chunks of MaxBatchSize and records the
+// outcome of send for every index in the chunk: the request error if it failed,
+// otherwise the per-memory failures reported in the response body
+func (c *Mem0Client) runBatches(ctx context.Context, indexes []int, results BatchResults, send func(chunk []int) (json.RawMessage, error)) {
+	for start := 0; start < len(indexes); start += MaxBatchSize {
+		end := start + MaxBatchSize
+		if end > len(indexes) {
+			end = len(indexes)
+		}
+		chunk := indexes[start:end]
+
+		var raw json.RawMessage
+		err := ctx.Err()
+		if err == nil {
+			raw, err = send(chunk)
+		}
+		if err != nil {
+			c.log(ctx, slog.LevelWarn, "mem0 batch failed", slog.Int("count", len(chunk)), c.errorAttr(err))
+			for _, idx := range chunk {
+				results[idx].Err = err
+			}
+			continue
+		}
+
+		// Bodies that are not a JSON object carry no per-memory outcome
+		var resp batchResponse
+		if json.Unmarshal(raw, &resp) != nil {
+			continue
+		}
+		itemErrs := resp.itemErrors()
+		for _, idx := range chunk {
+			if err, ok := itemErrs[results[idx].MemoryID]; ok {
+				results[idx].Err = err
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package mem0client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBatchDeletePerItemResults(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		// wantFailed lists the failed IDs in input order; the empty ID always fails validation
+		wantFailed []string
+	}{
+		{"message only", http.StatusOK, `{"message": "Successfully deleted 3 memories"}`, []string{""}},
+		{"per-item results", http.StatusOK, `{"results": [
+			{"memory_id": "mem-1", "status": "success"},
+			{"memory_id": "mem-2", "status": "not_found"},
+			{"memory_id": "mem-3", "error": "permission denied"}
+		]}`, []string{"mem-2", "", "mem-3"}},
+		{"failed list", http.StatusOK, `{"message": "partially deleted", "failed": [{"memory_id": "mem-3"}]}`, []string{"", "mem-3"}},
+		{"not an object", http.StatusOK, `"ok"`, []string{""}},
+		{"request failure", http.StatusBadRequest, `{"detail": "bad batch"}`, []string{"mem-1", "mem-2", "", "mem-3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+			c := NewMem0Client("test-key", WithBaseURL(srv.URL))
+
+			results, err := c.BatchDelete(context.Background(), []string{"mem-1", "mem-2", "", "mem-3"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := results.FailedIDs(); strings.Join(got, ",") != strings.Join(tt.wantFailed, ",") {
+				t.Errorf("FailedIDs() = %q, want %q", got, tt.wantFailed)
+			}
+		})
+	}
+}
+
+func TestBatchUpdateItemErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"results": [{"memory_id": "mem-1", "status": "success"}, {"memory_id": "mem-2", "status": "not_found"}]}`))
+	}))
+	defer srv.Close()
+	c := NewMem0Client("test-key", WithBaseURL(srv.URL))
+
+	results, err := c.BatchUpdate(context.Background(), []BatchUpdateItem{{MemoryID: "mem-1", Text: "a"}, {MemoryID: "mem-2", Text: "b"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Err != nil {
+		t.Errorf("mem-1 error = %v, want success", results[0].Err)
+	}
+	var notFound *MemoryNotFoundError
+	if !errors.As(results[1].Err, &notFound) || notFound.MemoryID != "mem-2" || !errors.Is(results[1].Err, ErrNotFound) {
+		t.Errorf("mem-2 error = %v, want MemoryNotFoundError", results[1].Err)
+	}
+}
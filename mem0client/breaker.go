@@ -0,0 +1,215 @@
+package mem0client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without contacting the API while the circuit breaker is open
+var ErrCircuitOpen = errors.New("mem0: circuit breaker is open")
+
+// CircuitState is the state of a circuit breaker
+type CircuitState int
+
+const (
+	// CircuitClosed lets every request through
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects every request until the cool-down has passed
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of probe requests through
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// CircuitBreakerOptions configures a CircuitBreaker. Zero values use the defaults.
+type CircuitBreakerOptions struct {
+	// FailureThreshold is the number of consecutive failures that opens the circuit (default 5)
+	FailureThreshold int
+	// CoolDown is how long the circuit stays open before probing the API again (default 30s)
+	CoolDown time.Duration
+	// HalfOpenMaxRequests is the number of concurrent probes allowed while half-open (default 1)
+	HalfOpenMaxRequests int
+	// SuccessThreshold is the number of successful probes that closes the circuit (default 1)
+	SuccessThreshold int
+	// IsFailure decides whether an attempt counts as a failure. By default transport
+	// errors and 5xx responses do. Requests cancelled by their caller are not passed
+	// to it and count neither as failures nor as successes.
+	IsFailure func(resp *http.Response, err error) bool
+	// OnStateChange is called synchronously after every state transition
+	OnStateChange func(from, to CircuitState)
+}
+
+// CircuitBreaker stops sending requests to a degraded API. It is safe for concurrent
+// use and can be shared by several clients.
+type CircuitBreaker struct {
+	opts CircuitBreakerOptions
+	now  func() time.Time
+
+	mu         sync.Mutex
+	state      CircuitState
+	generation uint64
+	failures   int
+	successes  int
+	inFlight   int
+	openedAt   time.Time
+}
+
+// NewCircuitBreaker creates a CircuitBreaker in the closed state
+func NewCircuitBreaker(opts CircuitBreakerOptions) *CircuitBreaker {
+	if opts.FailureThreshold <= 0 {
+		opts.FailureThreshold = 5
+	}
+	if opts.CoolDown <= 0 {
+		opts.CoolDown = 30 * time.Second
+	}
+	if opts.HalfOpenMaxRequests <= 0 {
+		opts.HalfOpenMaxRequests = 1
+	}
+	if opts.SuccessThreshold <= 0 {
+		opts.SuccessThreshold = 1
+	}
+	if opts.IsFailure == nil {
+		opts.IsFailure = defaultIsFailure
+	}
+	return &CircuitBreaker{opts: opts, now: time.Now}
+}
+
+// WithCircuitBreaker guards every request with breaker
+func WithCircuitBreaker(breaker *CircuitBreaker) func(*Mem0ClientConfig) {
+	return func(c *Mem0ClientConfig) {
+		c.CircuitBreaker = breaker
+	}
+}
+
+func defaultIsFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp.StatusCode >= 500
+}
+
+// State returns the current state, moving from open to half-open once the cool-down has passed
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	from := b.state
+	to := b.advance()
+	b.mu.Unlock()
+
+	b.notify(from, to)
+	return to
+}
+
+// advance moves an open circuit to half-open after the cool-down; callers hold mu
+func (b *CircuitBreaker) advance() CircuitState {
+	if b.state == CircuitOpen && b.now().Sub(b.openedAt) >= b.opts.CoolDown {
+		b.setState(CircuitHalfOpen)
+	}
+	return b.state
+}
+
+// setState switches state and resets the counters; callers hold mu
+func (b *CircuitBreaker) setState(state CircuitState) {
+	b.state = state
+	b.generation++
+	b.failures = 0
+	b.successes = 0
+	b.inFlight = 0
+	if state == CircuitOpen {
+		b.openedAt = b.now()
+	}
+}
+
+func (b *CircuitBreaker) notify(from, to CircuitState) {
+	if from != to && b.opts.OnStateChange != nil {
+		b.opts.OnStateChange(from, to)
+	}
+}
+
+// allow reports whether a request may be sent and returns the generation to record its outcome against
+func (b *CircuitBreaker) allow() (uint64, error) {
+	b.mu.Lock()
+	from := b.state
+	state := b.advance()
+
+	var err error
+	switch state {
+	case CircuitOpen:
+		err = ErrCircuitOpen
+	case CircuitHalfOpen:
+		if b.inFlight >= b.opts.HalfOpenMaxRequests {
+			err = ErrCircuitOpen
+		} else {
+			b.inFlight++
+		}
+	}
+	generation := b.generation
+	b.mu.Unlock()
+
+	b.notify(from, state)
+	return generation, err
+}
+
+// release gives back a half-open probe slot for a request that says nothing about
+// the API's health, because it was never sent or was cancelled by its caller
+func (b *CircuitBreaker) release(generation uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if generation == b.generation && b.state == CircuitHalfOpen {
+		b.inFlight--
+	}
+}
+
+// record updates the breaker with the outcome of a request allowed in generation.
+// Outcomes of requests started before the last state change are ignored, and
+// cancelled requests only release their probe slot.
+func (b *CircuitBreaker) record(generation uint64, resp *http.Response, err error) {
+	if errors.Is(err, context.Canceled) {
+		b.release(generation)
+		return
+	}
+	failed := b.opts.IsFailure(resp, err)
+
+	b.mu.Lock()
+	from := b.state
+	if generation == b.generation {
+		switch b.state {
+		case CircuitClosed:
+			if failed {
+				b.failures++
+				if b.failures >= b.opts.FailureThreshold {
+					b.setState(CircuitOpen)
+				}
+			} else {
+				b.failures = 0
+			}
+		case CircuitHalfOpen:
+			b.inFlight--
+			if failed {
+				b.setState(CircuitOpen)
+			} else {
+				b.successes++
+				if b.successes >= b.opts.SuccessThreshold {
+					b.setState(CircuitClosed)
+				}
+			}
+		}
+	}
+	to := b.state
+	b.mu.Unlock()
+
+	b.notify(from, to)
+}
@@ -0,0 +1,150 @@
+package mem0client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testBreaker returns a breaker driven by a fake clock and the transitions it reports
+func testBreaker(opts CircuitBreakerOptions) (*CircuitBreaker, *time.Time, *[]string) {
+	var transitions []string
+	opts.OnStateChange = func(from, to CircuitState) {
+		transitions = append(transitions, fmt.Sprintf("%s->%s", from, to))
+	}
+	b := NewCircuitBreaker(opts)
+	now := time.Unix(0, 0)
+	b.now = func() time.Time { return now }
+	return b, &now, &transitions
+}
+
+var (
+	okResponse     = &http.Response{StatusCode: http.StatusOK}
+	serverResponse = &http.Response{StatusCode: http.StatusServiceUnavailable}
+)
+
+func mustAllow(t *testing.T, b *CircuitBreaker) uint64 {
+	t.Helper()
+	generation, err := b.allow()
+	if err != nil {
+		t.Fatalf("allow() = %v in state %s", err, b.State())
+	}
+	return generation
+}
+
+func TestCircuitBreakerStateMachine(t *testing.T) {
+	b, now, transitions := testBreaker(CircuitBreakerOptions{FailureThreshold: 2, CoolDown: time.Minute})
+
+	// A success resets the consecutive failure count
+	b.record(mustAllow(t, b), serverResponse, nil)
+	b.record(mustAllow(t, b), okResponse, nil)
+	b.record(mustAllow(t, b), serverResponse, nil)
+	if got := b.State(); got != CircuitClosed {
+		t.Fatalf("state = %s after non-consecutive failures, want closed", got)
+	}
+
+	b.record(mustAllow(t, b), nil, errors.New("connection refused"))
+	if got := b.State(); got != CircuitOpen {
+		t.Fatalf("state = %s after %d consecutive failures, want open", got, 2)
+	}
+	if _, err := b.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("allow() = %v while open, want ErrCircuitOpen", err)
+	}
+
+	*now = now.Add(time.Minute)
+	if got := b.State(); got != CircuitHalfOpen {
+		t.Fatalf("state = %s after the cool-down, want half-open", got)
+	}
+
+	// Only one probe at a time, and a failed probe opens the circuit again
+	probe := mustAllow(t, b)
+	if _, err := b.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("allow() = %v with a probe in flight, want ErrCircuitOpen", err)
+	}
+	b.record(probe, serverResponse, nil)
+	if got := b.State(); got != CircuitOpen {
+		t.Fatalf("state = %s after a failed probe, want open", got)
+	}
+
+	*now = now.Add(time.Minute)
+	b.record(mustAllow(t, b), okResponse, nil)
+	if got := b.State(); got != CircuitClosed {
+		t.Fatalf("state = %s after a successful probe, want closed", got)
+	}
+
+	want := []string{"closed->open", "open->half-open", "half-open->open", "open->half-open", "half-open->closed"}
+	if !reflect.DeepEqual(*transitions, want) {
+		t.Errorf("OnStateChange saw %v, want %v", *transitions, want)
+	}
+}
+
+func TestCircuitBreakerIgnoresStaleOutcomes(t *testing.T) {
+	b, _, _ := testBreaker(CircuitBreakerOptions{FailureThreshold: 1})
+
+	stale := mustAllow(t, b)
+	b.record(mustAllow(t, b), serverResponse, nil)
+	b.record(stale, okResponse, nil)
+	if got := b.State(); got != CircuitOpen {
+		t.Errorf("state = %s, want a request started before opening not to close the circuit", got)
+	}
+}
+
+func TestCircuitBreakerCancellationIsNeutral(t *testing.T) {
+	b, now, transitions := testBreaker(CircuitBreakerOptions{FailureThreshold: 2, CoolDown: time.Minute})
+	cancelled := fmt.Errorf("request failed: %w", context.Canceled)
+
+	// A cancellation neither resets nor adds to the failure count
+	b.record(mustAllow(t, b), serverResponse, nil)
+	b.record(mustAllow(t, b), nil, cancelled)
+	b.record(mustAllow(t, b), serverResponse, nil)
+	if got := b.State(); got != CircuitOpen {
+		t.Fatalf("state = %s, want a cancellation between failures not to reset the count", got)
+	}
+
+	// A cancelled probe frees its slot without closing or reopening the circuit
+	*now = now.Add(time.Minute)
+	b.record(mustAllow(t, b), nil, cancelled)
+	if got := b.State(); got != CircuitHalfOpen {
+		t.Fatalf("state = %s after a cancelled probe, want half-open", got)
+	}
+	b.record(mustAllow(t, b), okResponse, nil)
+	if got := b.State(); got != CircuitClosed {
+		t.Fatalf("state = %s after a successful probe, want closed", got)
+	}
+
+	want := []string{"closed->open", "open->half-open", "half-open->closed"}
+	if !reflect.DeepEqual(*transitions, want) {
+		t.Errorf("OnStateChange saw %v, want %v", *transitions, want)
+	}
+}
+
+func TestCircuitBreakerCancelledRequest(t *testing.T) {
+	b, now, _ := testBreaker(CircuitBreakerOptions{FailureThreshold: 1, CoolDown: time.Minute})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+	c := NewMem0Client("test-key", WithBaseURL(srv.URL), WithCircuitBreaker(b))
+
+	if _, err := c.GetMemory(context.Background(), "mem-1"); err == nil {
+		t.Fatal("expected the 500 response to fail")
+	}
+	*now = now.Add(time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.GetMemory(ctx, "mem-1"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("GetMemory() = %v, want context.Canceled", err)
+	}
+	if got := b.State(); got != CircuitHalfOpen {
+		t.Errorf("state = %s after a cancelled probe, want half-open", got)
+	}
+	if _, err := b.allow(); err != nil {
+		t.Errorf("allow() = %v, want the cancelled probe to free its slot", err)
+	}
+}
@@ -0,0 +1,179 @@
+package mem0client
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ContentType identifies the kind of a multimodal message part
+type ContentType string
+
+const (
+	ContentTypeText     ContentType = "text"
+	ContentTypeImageURL ContentType = "image_url"
+	ContentTypePDFURL   ContentType = "pdf_url"
+	ContentTypeMDXURL   ContentType = "mdx_url"
+)
+
+// ContentURL points at the content of an image or document part. The URL may
+// be a remote URL or inline data built by the From* helpers.
+type ContentURL struct {
+	URL string `json:"url"`
+}
+
+// ContentPart is a single typed piece of message content
+type ContentPart struct {
+	Type     ContentType `json:"type"`
+	Text     string      `json:"text,omitempty"`
+	ImageURL *ContentURL `json:"image_url,omitempty"`
+	PDFURL   *ContentURL `json:"pdf_url,omitempty"`
+	MDXURL   *ContentURL `json:"mdx_url,omitempty"`
+}
+
+// TextPart creates a plain text part
+func TextPart(text string) ContentPart {
+	return ContentPart{Type: ContentTypeText, Text: text}
+}
+
+// ImageURLPart creates an image part pointing at a remote image
+func ImageURLPart(url string) ContentPart {
+	return ContentPart{Type: ContentTypeImageURL, ImageURL: &ContentURL{URL: url}}
+}
+
+// PDFURLPart creates a document part pointing at a remote PDF
+func PDFURLPart(url string) ContentPart {
+	return ContentPart{Type: ContentTypePDFURL, PDFURL: &ContentURL{URL: url}}
+}
+
+// MDXURLPart creates a document part pointing at a remote MDX or text document
+func MDXURLPart(url string) ContentPart {
+	return ContentPart{Type: ContentTypeMDXURL, MDXURL: &ContentURL{URL: url}}
+}
+
+// NewMessage creates a message made of typed content parts
+func NewMessage(role string, parts ...ContentPart) Message {
+	return Message{Role: role, Parts: parts}
+}
+
+// ContentPartFromReader reads r and builds an inline part from its sniffed MIME type:
+// images become base64 image_url parts and plain text or markdown becomes an mdx_url
+// part. PDFs are rejected because the API only accepts them by URL; use PDFURLPart.
+// name is optional and only used to refine detection of markdown and MDX files by extension.
+func ContentPartFromReader(r io.Reader, name string) (ContentPart, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return ContentPart{}, fmt.Errorf("failed to read content: %v", err)
+	}
+	if len(data) == 0 {
+		return ContentPart{}, fmt.Errorf("content cannot be empty")
+	}
+
+	mimeType := detectContentMIME(data, name)
+	encoded := base64.StdEncoding.EncodeToString(data)
+
+	switch {
+	case strings.HasPrefix(mimeType, "image/"):
+		return ImageURLPart("data:" + mimeType + ";base64," + encoded), nil
+	case mimeType == "application/pdf":
+		return ContentPart{}, fmt.Errorf("PDF content must be given by URL, use PDFURLPart")
+	case mimeType == "text/plain" || mimeType == "text/markdown":
+		return MDXURLPart(encoded), nil
+	}
+
+	return ContentPart{}, fmt.Errorf("unsupported content type: %s", mimeType)
+}
+
+// ContentPartFromFile builds an inline part from a local image, MDX, markdown or text file
+func ContentPartFromFile(path string) (ContentPart, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return ContentPart{}, fmt.Errorf("failed to open file: %v", err)
+	}
+	defer f.Close()
+
+	return ContentPartFromReader(f, filepath.Base(path))
+}
+
+// detectContentMIME sniffs the MIME type of data, falling back to the file
+// extension for text formats that sniffing cannot tell apart
+func detectContentMIME(data []byte, name string) string {
+	mimeType := http.DetectContentType(data)
+	if i := strings.Index(mimeType, ";"); i >= 0 {
+		mimeType = mimeType[:i]
+	}
+
+	if mimeType == "text/plain" || mimeType == "application/octet-stream" {
+		switch strings.ToLower(filepath.Ext(name)) {
+		case ".md", ".mdx":
+			return "text/markdown"
+		case ".txt":
+			return "text/plain"
+		}
+	}
+	return mimeType
+}
+
+// MarshalJSON keeps plain text messages as {"role": ..., "content": "..."} and
+// serializes messages with parts as a single content object or a list of them
+func (m Message) MarshalJSON() ([]byte, error) {
+	if len(m.Parts) == 0 {
+		return json.Marshal(struct {
+			Role    string `json:"role"`
+			Content string `json:"content"`
+		}{m.Role, m.Content})
+	}
+
+	parts := m.Parts
+	if m.Content != "" {
+		parts = append([]ContentPart{TextPart(m.Content)}, m.Parts...)
+	}
+
+	var content interface{} = parts
+	if len(parts) == 1 {
+		content = parts[0]
+	}
+
+	return json.Marshal(struct {
+		Role    string      `json:"role"`
+		Content interface{} `json:"content"`
+	}{m.Role, content})
+}
+
+// UnmarshalJSON accepts content as a string, a single part object or a list of parts
+func (m *Message) UnmarshalJSON(data []byte) error {
+	var wire struct {
+		Role    string          `json:"role"`
+		Content json.RawMessage `json:"content"`
+	}
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+
+	*m = Message{Role: wire.Role}
+
+	content := bytes.TrimSpace(wire.Content)
+	if len(content) == 0 || bytes.Equal(content, []byte("null")) {
+		return nil
+	}
+
+	switch content[0] {
+	case '"':
+		return json.Unmarshal(content, &m.Content)
+	case '[':
+		return json.Unmarshal(content, &m.Parts)
+	default:
+		var part ContentPart
+		if err := json.Unmarshal(content, &part); err != nil {
+			return err
+		}
+		m.Parts = []ContentPart{part}
+		return nil
+	}
+}
@@ -0,0 +1,44 @@
+package mem0client
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestContentPartFromReader(t *testing.T) {
+	png := "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"
+
+	tests := []struct {
+		name, file, data string
+		wantType         ContentType
+		wantErr          string
+	}{
+		{"image", "photo.png", png, ContentTypeImageURL, ""},
+		{"plain text", "notes.txt", "Alex likes tea", ContentTypeMDXURL, ""},
+		{"markdown", "notes.md", "# Preferences\n\n- tea", ContentTypeMDXURL, ""},
+		{"mdx without name", "", "# Preferences", ContentTypeMDXURL, ""},
+		{"local pdf", "report.pdf", "%PDF-1.7\n", "", "PDFURLPart"},
+		{"html", "page.html", "<!DOCTYPE html><html><body>hi</body></html>", "", "unsupported content type: text/html"},
+		{"xml", "feed.xml", "<?xml version=\"1.0\"?><feed/>", "", "unsupported content type: text/xml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			part, err := ContentPartFromReader(strings.NewReader(tt.data), tt.file)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if part.Type != tt.wantType {
+				t.Errorf("type = %s, want %s", part.Type, tt.wantType)
+			}
+			if part.Type == ContentTypeImageURL && !strings.HasPrefix(part.ImageURL.URL, "data:image/png;base64,") {
+				t.Errorf("image URL = %.40s, want a base64 data URL", part.ImageURL.URL)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package mem0client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+)
+
+// EntityType identifies what kind of entity owns memories
+type EntityType string
+
+const (
+	EntityTypeUser  EntityType = "user"
+	EntityTypeAgent EntityType = "agent"
+	EntityTypeApp   EntityType = "app"
+	EntityTypeRun   EntityType = "run"
+)
+
+// valid reports whether t is one of the entity types known to the API
+func (t EntityType) valid() bool {
+	switch t {
+	case EntityTypeUser, EntityTypeAgent, EntityTypeApp, EntityTypeRun:
+		return true
+	}
+	return false
+}
+
+// Entity represents a user, agent, app or run that has memories
+type Entity struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	Type          EntityType `json:"type"`
+	TotalMemories int        `json:"total_memories"`
+	Owner         string     `json:"owner"`
+	Organization  string     `json:"organization"`
+	Metadata      Metadata   `json:"metadata"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// EntityFilter narrows the entities returned by ListEntities
+type EntityFilter struct {
+	// Type keeps only entities of the given type when set
+	Type      EntityType
+	OrgID     string
+	ProjectID string
+}
+
+// DeleteEntityRequest holds the arguments of DeleteEntity
+type DeleteEntityRequest struct {
+	Type     EntityType
+	EntityID string
+}
+
+// ListEntities lists every entity that has memories, optionally filtered by type
+func (c *Mem0Client) ListEntities(ctx context.Context, filter *EntityFilter) ([]Entity, error) {
+	return runOperation(c, ctx, OpListEntities, filter, c.listEntities)
+}
+
+func (c *Mem0Client) listEntities(ctx context.Context, filter *EntityFilter) ([]Entity, error) {
+	if filter == nil {
+		filter = &EntityFilter{}
+	}
+	if filter.Type != "" && !filter.Type.valid() {
+		return nil, fmt.Errorf("invalid entity type: %s", filter.Type)
+	}
+
+	q := url.Values{}
+	if filter.OrgID != "" {
+		q.Add("org_id", filter.OrgID)
+	}
+	if filter.ProjectID != "" {
+		q.Add("project_id", filter.ProjectID)
+	}
+	c.scopeQuery(ctx, q)
+
+	reqURL := c.config.BaseURL + "/entities/"
+	if len(q) > 0 {
+		reqURL += "?" + q.Encode()
+	}
+
+	var raw json.RawMessage
+	if _, err := c.doJSON(ctx, "GET", reqURL, nil, &raw); err != nil {
+		return nil, err
+	}
+
+	var entities []Entity
+	if err := decodeResultList(raw, &entities); err != nil {
+		return nil, err
+	}
+
+	if filter.Type != "" {
+		filtered := entities[:0]
+		for _, e := range entities {
+			if e.Type == filter.Type {
+				filtered = append(filtered, e)
+			}
+		}
+		entities = filtered
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 listed entities", slog.Int("count", len(entities)))
+	return entities, nil
+}
+
+// DeleteEntity deletes an entity and all of its memories
+func (c *Mem0Client) DeleteEntity(ctx context.Context, entityType EntityType, entityID string) error {
+	return runErrOperation(c, ctx, OpDeleteEntity, &DeleteEntityRequest{Type: entityType, EntityID: entityID}, c.deleteEntity)
+}
+
+func (c *Mem0Client) deleteEntity(ctx context.Context, r *DeleteEntityRequest) error {
+	if r == nil {
+		return fmt.Errorf("delete entity request cannot be nil")
+	}
+	entityType, entityID := r.Type, r.EntityID
+
+	if !entityType.valid() {
+		return fmt.Errorf("invalid entity type: %s", entityType)
+	}
+	if entityID == "" {
+		return fmt.Errorf("entity id is required")
+	}
+
+	reqURL := c.scopedURL(ctx, fmt.Sprintf("%s/entities/%s/%s/", c.baseURLFor("v2"), entityType, url.PathEscape(entityID)))
+	if _, err := c.doJSON(ctx, "DELETE", reqURL, nil, nil); err != nil {
+		return err
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 deleted entity", slog.String("entity_type", string(entityType)), slog.String("entity_id", entityID))
+	return nil
+}
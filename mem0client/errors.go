@@ -0,0 +1,152 @@
+package mem0client
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors matched by API errors with errors.Is according to their HTTP status
+var (
+	// ErrNotFound matches 404 responses, including MemoryNotFoundError
+	ErrNotFound = errors.New("mem0: not found")
+	// ErrUnauthorized matches 401 responses, e.g. an invalid API key
+	ErrUnauthorized = errors.New("mem0: unauthorized")
+	// ErrForbidden matches 403 responses
+	ErrForbidden = errors.New("mem0: forbidden")
+	// ErrRateLimited matches 429 responses; see Mem0Error.RetryAfter
+	ErrRateLimited = errors.New("mem0: rate limited")
+	// ErrValidation matches 400 and 422 responses; see Mem0Error.Fields
+	ErrValidation = errors.New("mem0: validation failed")
+	// ErrServer matches 5xx responses
+	ErrServer = errors.New("mem0: server error")
+)
+
+// maxErrorDetail caps the length of a non-JSON error body kept as the error detail
+const maxErrorDetail = 512
+
+// Is reports whether the error's HTTP status falls in the class of target, one of the sentinel errors
+func (e *Mem0Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrValidation:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	case ErrServer:
+		return e.StatusCode >= 500
+	}
+	return false
+}
+
+// Is makes MemoryNotFoundError match ErrNotFound
+func (e *MemoryNotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// Unwrap returns the API error behind the not found error, if any
+func (e *MemoryNotFoundError) Unwrap() error {
+	return e.Err
+}
+
+// nonJSONErrorDetail returns the detail of an error body that is not a Mem0 error object:
+// the "error" or "message" field of other JSON objects, or the trimmed body text
+func nonJSONErrorDetail(body []byte) string {
+	var wire struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &wire) == nil {
+		if wire.Error != "" {
+			return wire.Error
+		}
+		if wire.Message != "" {
+			return wire.Message
+		}
+	}
+
+	text := strings.TrimSpace(string(body))
+	if len(text) > maxErrorDetail {
+		text = text[:maxErrorDetail] + "..."
+	}
+	return text
+}
+
+// errorEnvelopeKeys are the keys of an error body that describe the error as a whole
+var errorEnvelopeKeys = map[string]bool{
+	"detail":   true,
+	"code":     true,
+	"messages": true,
+	"error":    true,
+	"message":  true,
+}
+
+// parseValidationFields extracts field-level validation errors from a 400 or 422 body.
+// It understands {"field": ["message", ...]} objects, optionally nested under
+// "errors", and {"detail": [{"loc": [...], "msg": "..."}]} lists. Next to the keys
+// of an error envelope, such as "detail" or "message", only list values are fields,
+// so that e.g. a "request_id" string is not taken for one.
+func parseValidationFields(body []byte) map[string][]string {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil
+	}
+
+	fields := map[string][]string{}
+
+	var details []struct {
+		Loc []interface{} `json:"loc"`
+		Msg string        `json:"msg"`
+	}
+	if detail, ok := raw["detail"]; ok && json.Unmarshal(detail, &details) == nil {
+		for _, d := range details {
+			var path []string
+			for i, part := range d.Loc {
+				// The first element names where the field lives, e.g. "body" or "query"
+				if s, ok := part.(string); ok && !(i == 0 && len(d.Loc) > 1) {
+					path = append(path, s)
+				}
+			}
+			if d.Msg != "" && len(path) > 0 {
+				key := strings.Join(path, ".")
+				fields[key] = append(fields[key], d.Msg)
+			}
+		}
+	}
+
+	listsOnly := false
+	for key := range raw {
+		if errorEnvelopeKeys[key] {
+			listsOnly = true
+		}
+	}
+	if nested, ok := raw["errors"]; ok {
+		var inner map[string]json.RawMessage
+		if json.Unmarshal(nested, &inner) == nil {
+			raw, listsOnly = inner, false
+		}
+	}
+	for key, value := range raw {
+		if errorEnvelopeKeys[key] || key == "errors" {
+			continue
+		}
+		var list []string
+		var s string
+		if json.Unmarshal(value, &list) == nil {
+			fields[key] = append(fields[key], list...)
+		} else if !listsOnly && json.Unmarshal(value, &s) == nil {
+			fields[key] = append(fields[key], s)
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
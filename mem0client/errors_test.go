@@ -0,0 +1,136 @@
+package mem0client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// errorServer answers every request with status and body
+func errorServer(t *testing.T, status int, header http.Header, body string) *Mem0Client {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return NewMem0Client("test-key", WithBaseURL(srv.URL))
+}
+
+func TestErrorIsMapsStatus(t *testing.T) {
+	sentinels := []error{ErrNotFound, ErrUnauthorized, ErrForbidden, ErrRateLimited, ErrValidation, ErrServer}
+
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusBadRequest, ErrValidation},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusConflict, nil},
+		{http.StatusUnprocessableEntity, ErrValidation},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusInternalServerError, ErrServer},
+		{http.StatusServiceUnavailable, ErrServer},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			c := errorServer(t, tt.status, nil, `{"detail": "nope"}`)
+
+			_, err := c.GetMemories(context.Background(), &GetMemoriesOptions{UserID: "alex"})
+			var apiErr *Mem0Error
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tt.status {
+				t.Fatalf("GetMemories() = %v, want a *Mem0Error with status %d", err, tt.status)
+			}
+			for _, sentinel := range sentinels {
+				if got := errors.Is(err, sentinel); got != (sentinel == tt.want) {
+					t.Errorf("errors.Is(err, %v) = %v", sentinel, got)
+				}
+			}
+		})
+	}
+}
+
+func TestMemoryNotFoundErrorIs(t *testing.T) {
+	c := errorServer(t, http.StatusNotFound, http.Header{"X-Request-Id": {"req-1"}}, `{"detail": "Memory not found"}`)
+
+	_, err := c.GetMemory(context.Background(), "mem-1")
+	var notFound *MemoryNotFoundError
+	if !errors.As(err, &notFound) || notFound.MemoryID != "mem-1" {
+		t.Fatalf("GetMemory() = %v, want a *MemoryNotFoundError for mem-1", err)
+	}
+	if !errors.Is(err, ErrNotFound) || errors.Is(err, ErrServer) {
+		t.Errorf("errors.Is() does not map %v to ErrNotFound only", err)
+	}
+	var apiErr *Mem0Error
+	if !errors.As(err, &apiErr) || apiErr.RequestID != "req-1" || apiErr.Op != OpGetMemory {
+		t.Errorf("underlying API error = %+v, want request ID and operation", apiErr)
+	}
+}
+
+func TestRateLimitedErrorRetryAfter(t *testing.T) {
+	c := errorServer(t, http.StatusTooManyRequests, http.Header{"Retry-After": {"7"}}, `{"detail": "slow down"}`)
+
+	_, err := c.GetMemories(context.Background(), &GetMemoriesOptions{UserID: "alex"})
+	var apiErr *Mem0Error
+	if !errors.As(err, &apiErr) || apiErr.RetryAfter != 7*time.Second {
+		t.Errorf("GetMemories() = %v, want RetryAfter 7s", err)
+	}
+}
+
+func TestValidationErrorFields(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		wantFields map[string][]string
+		wantDetail string
+	}{
+		{"loc and msg list", http.StatusUnprocessableEntity,
+			`{"detail": [{"loc": ["body", "messages", 0, "content"], "msg": "field required", "type": "missing"}, {"loc": ["query", "page"], "msg": "not an integer"}]}`,
+			map[string][]string{"messages.content": {"field required"}, "page": {"not an integer"}}, ""},
+		{"errors object", http.StatusBadRequest,
+			`{"message": "Invalid request", "errors": {"user_id": ["This field is required."], "page_size": "must be positive"}}`,
+			map[string][]string{"user_id": {"This field is required."}, "page_size": {"must be positive"}}, ""},
+		{"bare field map", http.StatusBadRequest,
+			`{"user_id": ["This field is required."], "filters": "Invalid filter"}`,
+			map[string][]string{"user_id": {"This field is required."}, "filters": {"Invalid filter"}}, ""},
+		{"envelope with list fields", http.StatusBadRequest,
+			`{"detail": "Invalid request", "request_id": "abc", "user_id": ["This field is required."]}`,
+			map[string][]string{"user_id": {"This field is required."}}, "Invalid request"},
+		{"envelope only", http.StatusBadRequest,
+			`{"detail": "Invalid filters", "request_id": "abc"}`,
+			nil, "Invalid filters"},
+		{"error string", http.StatusBadRequest,
+			`{"error": "Invalid page", "trace": "xyz"}`,
+			nil, "Invalid page"},
+		{"not validated on other statuses", http.StatusConflict,
+			`{"user_id": ["taken"]}`,
+			nil, `{"user_id": ["taken"]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := errorServer(t, tt.status, nil, tt.body)
+
+			_, err := c.GetMemories(context.Background(), &GetMemoriesOptions{UserID: "alex"})
+			var apiErr *Mem0Error
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("GetMemories() = %v, want a *Mem0Error", err)
+			}
+			if !reflect.DeepEqual(apiErr.Fields, tt.wantFields) {
+				t.Errorf("Fields = %v, want %v", apiErr.Fields, tt.wantFields)
+			}
+			if apiErr.Detail != tt.wantDetail {
+				t.Errorf("Detail = %q, want %q", apiErr.Detail, tt.wantDetail)
+			}
+		})
+	}
+}
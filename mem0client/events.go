@@ -0,0 +1,114 @@
+package mem0client
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+)
+
+// EventStatus is the processing state of an asynchronous event
+type EventStatus string
+
+const (
+	EventStatusPending   EventStatus = "PENDING"
+	EventStatusRunning   EventStatus = "RUNNING"
+	EventStatusSucceeded EventStatus = "SUCCEEDED"
+	EventStatusFailed    EventStatus = "FAILED"
+)
+
+// defaultPollInterval is used by WaitForEvent and WaitForExport when no interval is given
+const defaultPollInterval = time.Second
+
+// AsyncEvent represents a queued add operation and, once processed, its results
+type AsyncEvent struct {
+	ID        string      `json:"id"`
+	EventType string      `json:"event_type"`
+	Status    EventStatus `json:"status"`
+	Results   []AddEvent  `json:"results"`
+	Metadata  Metadata    `json:"metadata,omitempty"`
+	Latency   float64     `json:"latency,omitempty"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// Done reports whether the event has finished processing, successfully or not
+func (e *AsyncEvent) Done() bool {
+	return e.Status == EventStatusSucceeded || e.Status == EventStatusFailed
+}
+
+// WaitRequest holds the arguments of WaitForEvent and WaitForExport
+type WaitRequest struct {
+	// ID is the event or export ID
+	ID           string
+	PollInterval time.Duration
+}
+
+// GetEvent retrieves the current state of an asynchronous event
+func (c *Mem0Client) GetEvent(ctx context.Context, eventID string) (*AsyncEvent, error) {
+	return runOperation(c, ctx, OpGetEvent, eventID, c.getEvent)
+}
+
+func (c *Mem0Client) getEvent(ctx context.Context, eventID string) (*AsyncEvent, error) {
+	if eventID == "" {
+		return nil, fmt.Errorf("event id is required")
+	}
+
+	var event AsyncEvent
+	reqURL := c.scopedURL(ctx, fmt.Sprintf("%s/event/%s/", c.config.BaseURL, url.PathEscape(eventID)))
+	if _, err := c.doJSON(ctx, "GET", reqURL, nil, &event); err != nil {
+		return nil, err
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 got event", slog.String("event_id", event.ID), slog.String("event_status", string(event.Status)))
+	return &event, nil
+}
+
+// WaitForEvent polls an asynchronous event every pollInterval until it has been
+// processed and returns the resulting memory events. It stops when ctx is done.
+func (c *Mem0Client) WaitForEvent(ctx context.Context, eventID string, pollInterval time.Duration) (*AddResult, error) {
+	return runOperation(c, ctx, OpWaitForEvent, &WaitRequest{ID: eventID, PollInterval: pollInterval}, c.waitForEvent)
+}
+
+func (c *Mem0Client) waitForEvent(ctx context.Context, r *WaitRequest) (*AddResult, error) {
+	if r == nil {
+		return nil, fmt.Errorf("wait request cannot be nil")
+	}
+	eventID, pollInterval := r.ID, r.PollInterval
+
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		event, err := c.getEvent(ctx, eventID)
+		if err != nil {
+			return nil, err
+		}
+
+		if event.Done() {
+			result := &AddResult{
+				Format:  OutputFormatV11,
+				Events:  event.Results,
+				EventID: event.ID,
+				Status:  event.Status,
+			}
+			if event.Status == EventStatusFailed {
+				return result, fmt.Errorf("event %s failed", eventID)
+			}
+			return result, nil
+		}
+
+		timer.Reset(pollInterval)
+	}
+}
@@ -0,0 +1,273 @@
+package mem0client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// ExportStatus is the processing state of a memory export job
+type ExportStatus string
+
+const (
+	ExportStatusPending    ExportStatus = "PENDING"
+	ExportStatusProcessing ExportStatus = "PROCESSING"
+	ExportStatusCompleted  ExportStatus = "COMPLETED"
+	ExportStatusFailed     ExportStatus = "FAILED"
+)
+
+// Export is a structured export of memories shaped by a JSON schema
+type Export struct {
+	ID     string       `json:"id"`
+	Status ExportStatus `json:"status"`
+	// Result holds the exported data when the API returns it inline
+	Result json.RawMessage `json:"result,omitempty"`
+	// DownloadURL points at the exported data when the API serves it as a file
+	DownloadURL string    `json:"download_url,omitempty"`
+	Error       string    `json:"error,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Done reports whether the export has finished, successfully or not
+func (e *Export) Done() bool {
+	return strings.EqualFold(string(e.Status), string(ExportStatusCompleted)) ||
+		strings.EqualFold(string(e.Status), string(ExportStatusFailed))
+}
+
+// Failed reports whether the export finished with an error
+func (e *Export) Failed() bool {
+	return strings.EqualFold(string(e.Status), string(ExportStatusFailed))
+}
+
+// CreateExportRequest holds the arguments of CreateExport
+type CreateExportRequest struct {
+	Schema  interface{}
+	Filters Filter
+}
+
+// WriteExportRequest holds the arguments of WriteExport
+type WriteExportRequest struct {
+	Export *Export
+	Writer io.Writer
+}
+
+// CreateExport requests a structured export of the memories matching filters,
+// shaped by schema. schema can be any value that marshals to a JSON schema,
+// such as a map or json.RawMessage. A zero filter exports every memory.
+func (c *Mem0Client) CreateExport(ctx context.Context, schema interface{}, filters Filter) (*Export, error) {
+	return runOperation(c, ctx, OpCreateExport, &CreateExportRequest{Schema: schema, Filters: filters}, c.createExport)
+}
+
+func (c *Mem0Client) createExport(ctx context.Context, r *CreateExportRequest) (*Export, error) {
+	if r == nil {
+		return nil, fmt.Errorf("create export request cannot be nil")
+	}
+	schema, filters := r.Schema, r.Filters
+
+	if schema == nil {
+		return nil, fmt.Errorf("schema is required for creating an export")
+	}
+
+	payload := map[string]interface{}{"schema": schema}
+	if !filters.IsZero() {
+		if err := filters.Validate(); err != nil {
+			return nil, err
+		}
+		payload["filters"] = rootFilter(filters)
+	}
+	c.scopePayload(ctx, payload)
+
+	var export Export
+	if _, err := c.doJSON(ctx, "POST", c.config.BaseURL+"/exports/", payload, &export); err != nil {
+		return nil, err
+	}
+	if export.Status == "" {
+		export.Status = ExportStatusPending
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 created export", slog.String("export_id", export.ID))
+	return &export, nil
+}
+
+// GetExport retrieves the current state of an export job
+func (c *Mem0Client) GetExport(ctx context.Context, exportID string) (*Export, error) {
+	return runOperation(c, ctx, OpGetExport, exportID, c.getExport)
+}
+
+func (c *Mem0Client) getExport(ctx context.Context, exportID string) (*Export, error) {
+	if exportID == "" {
+		return nil, fmt.Errorf("export id is required")
+	}
+
+	payload := map[string]interface{}{"memory_export_id": exportID}
+	c.scopePayload(ctx, payload)
+
+	var raw json.RawMessage
+	if _, err := c.doJSON(markReadOnly(ctx), "POST", c.config.BaseURL+"/exports/get/", payload, &raw); err != nil {
+		return nil, err
+	}
+
+	export, err := decodeExport(raw)
+	if err != nil {
+		return nil, err
+	}
+	if export.ID == "" {
+		export.ID = exportID
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 got export", slog.String("export_id", export.ID), slog.String("export_status", string(export.Status)))
+	return export, nil
+}
+
+// decodeExport decodes an export job, or a finished export returned as its result or
+// download URL without a status. Other bodies are rejected rather than taken for data.
+func decodeExport(raw json.RawMessage) (*Export, error) {
+	var export Export
+	if err := json.Unmarshal(raw, &export); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %v", err)
+	}
+
+	switch ExportStatus(strings.ToUpper(string(export.Status))) {
+	case ExportStatusPending, ExportStatusProcessing, ExportStatusCompleted, ExportStatusFailed:
+	case "":
+		if len(export.Result) == 0 && export.DownloadURL == "" {
+			return nil, fmt.Errorf("failed to decode response: no export status, result or download URL")
+		}
+		export.Status = ExportStatusCompleted
+	default:
+		return nil, fmt.Errorf("failed to decode response: unknown export status %q", export.Status)
+	}
+	return &export, nil
+}
+
+// WaitForExport polls an export every pollInterval until it has finished.
+// It stops when ctx is done.
+func (c *Mem0Client) WaitForExport(ctx context.Context, exportID string, pollInterval time.Duration) (*Export, error) {
+	return runOperation(c, ctx, OpWaitForExport, &WaitRequest{ID: exportID, PollInterval: pollInterval}, c.waitForExport)
+}
+
+func (c *Mem0Client) waitForExport(ctx context.Context, r *WaitRequest) (*Export, error) {
+	if r == nil {
+		return nil, fmt.Errorf("wait request cannot be nil")
+	}
+	exportID, pollInterval := r.ID, r.PollInterval
+
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		export, err := c.getExport(ctx, exportID)
+		if err != nil {
+			return nil, err
+		}
+
+		if export.Done() {
+			if export.Failed() {
+				return export, fmt.Errorf("export %s failed: %s", exportID, export.Error)
+			}
+			return export, nil
+		}
+
+		timer.Reset(pollInterval)
+	}
+}
+
+// WriteExport streams the data of a finished export to w
+func (c *Mem0Client) WriteExport(ctx context.Context, export *Export, w io.Writer) error {
+	return runErrOperation(c, ctx, OpWriteExport, &WriteExportRequest{Export: export, Writer: w}, c.writeExport)
+}
+
+func (c *Mem0Client) writeExport(ctx context.Context, r *WriteExportRequest) error {
+	if r == nil {
+		return fmt.Errorf("write export request cannot be nil")
+	}
+	export, w := r.Export, r.Writer
+
+	if export == nil {
+		return fmt.Errorf("export cannot be nil")
+	}
+	if !export.Done() || export.Failed() {
+		return fmt.Errorf("export %s is not completed (status: %s)", export.ID, export.Status)
+	}
+
+	if export.DownloadURL == "" {
+		if _, err := io.Copy(w, bytes.NewReader(export.Result)); err != nil {
+			return fmt.Errorf("failed to write export: %v", err)
+		}
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", export.DownloadURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+	// Only send credentials to the API itself, not to pre-signed storage URLs
+	if sameOrigin(req.URL, c.config.BaseURL) {
+		c.prepareRequest(req)
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return c.parseErrorResponse(resp)
+	}
+
+	n, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to write export: %v", err)
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 downloaded export", slog.String("export_id", export.ID), slog.Int64("bytes", n))
+	return nil
+}
+
+// sameOrigin reports whether u has the scheme and host of baseURL
+func sameOrigin(u *url.URL, baseURL string) bool {
+	base, err := url.Parse(baseURL)
+	if err != nil || base.Host == "" {
+		return false
+	}
+	return strings.EqualFold(u.Scheme, base.Scheme) && strings.EqualFold(u.Host, base.Host)
+}
+
+// WriteExportToFile streams the data of a finished export to a file at path,
+// creating or truncating it
+func (c *Mem0Client) WriteExportToFile(ctx context.Context, export *Export, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %v", err)
+	}
+
+	if err := c.WriteExport(ctx, export, f); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close file: %v", err)
+	}
+	return nil
+}
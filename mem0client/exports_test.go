@@ -0,0 +1,104 @@
+package mem0client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestSameOrigin(t *testing.T) {
+	tests := []struct {
+		downloadURL, baseURL string
+		want                 bool
+	}{
+		{"https://api.mem0.ai/v1/exports/1/download", "https://api.mem0.ai/v1", true},
+		{"https://API.mem0.ai/file", "https://api.mem0.ai/v1", true},
+		{"https://api.mem0.ai.evil.example/file", "https://api.mem0.ai", false},
+		{"https://api.mem0.ai@evil.example/file", "https://api.mem0.ai", false},
+		{"http://api.mem0.ai/file", "https://api.mem0.ai", false},
+		{"https://api.mem0.ai:8443/file", "https://api.mem0.ai", false},
+		{"https://storage.example/export?sig=abc", "https://api.mem0.ai", false},
+		{"https://api.mem0.ai/file", "not a url", false},
+	}
+	for _, tt := range tests {
+		u, err := url.Parse(tt.downloadURL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := sameOrigin(u, tt.baseURL); got != tt.want {
+			t.Errorf("sameOrigin(%q, %q) = %v, want %v", tt.downloadURL, tt.baseURL, got, tt.want)
+		}
+	}
+}
+
+func TestWriteExportCredentials(t *testing.T) {
+	var gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.Write([]byte(`{"memories": []}`))
+	}))
+	defer srv.Close()
+
+	for _, tt := range []struct {
+		baseURL  string
+		wantAuth bool
+	}{
+		{srv.URL, true},
+		{"https://api.mem0.ai", false},
+	} {
+		gotAuth = ""
+		c := NewMem0Client("test-key", WithBaseURL(tt.baseURL))
+		export := &Export{ID: "exp-1", Status: ExportStatusCompleted, DownloadURL: srv.URL + "/download/exp-1"}
+		if err := c.WriteExport(context.Background(), export, io.Discard); err != nil {
+			t.Fatal(err)
+		}
+		if (gotAuth != "") != tt.wantAuth {
+			t.Errorf("base URL %s: Authorization = %q, want sent: %v", tt.baseURL, gotAuth, tt.wantAuth)
+		}
+	}
+}
+
+func TestGetExportDecoding(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus ExportStatus
+		wantErr    string
+	}{
+		{"pending job", `{"id": "exp-1", "status": "PENDING"}`, ExportStatusPending, ""},
+		{"lower-case status", `{"id": "exp-1", "status": "completed", "result": {"memories": []}}`, "completed", ""},
+		{"inline result", `{"result": {"memories": []}}`, ExportStatusCompleted, ""},
+		{"download URL", `{"download_url": "https://storage.example/exp-1"}`, ExportStatusCompleted, ""},
+		{"unknown shape", `{"detail": "queued"}`, "", "no export status"},
+		{"unknown status", `{"status": "EXPLODED"}`, "", "unknown export status"},
+		{"not an object", `["a", "b"]`, "", "failed to decode response"},
+		{"not JSON", `<html>oops</html>`, "", "failed to decode response"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+			c := NewMem0Client("test-key", WithBaseURL(srv.URL))
+
+			export, err := c.GetExport(context.Background(), "exp-1")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("GetExport() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if export.Status != tt.wantStatus || export.ID != "exp-1" {
+				t.Errorf("GetExport() = id %q status %q, want exp-1 %q", export.ID, export.Status, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package mem0client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+)
+
+// FeedbackType rates the quality of a memory
+type FeedbackType string
+
+const (
+	FeedbackPositive     FeedbackType = "POSITIVE"
+	FeedbackNegative     FeedbackType = "NEGATIVE"
+	FeedbackVeryNegative FeedbackType = "VERY_NEGATIVE"
+)
+
+// Feedback is a rating attached to a memory
+type Feedback struct {
+	ID             string       `json:"id"`
+	MemoryID       string       `json:"memory_id"`
+	Feedback       FeedbackType `json:"feedback"`
+	FeedbackReason *string      `json:"feedback_reason,omitempty"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
+type feedbackRequest struct {
+	MemoryID       string       `json:"memory_id"`
+	Feedback       FeedbackType `json:"feedback"`
+	FeedbackReason *string      `json:"feedback_reason,omitempty"`
+	OrgID          string       `json:"org_id,omitempty"`
+	ProjectID      string       `json:"project_id,omitempty"`
+}
+
+// SubmitFeedbackRequest holds the arguments of SubmitFeedback
+type SubmitFeedbackRequest struct {
+	MemoryID string
+	Feedback FeedbackType
+	Reason   string
+}
+
+// SubmitFeedback rates a memory. reason is optional and may be empty.
+func (c *Mem0Client) SubmitFeedback(ctx context.Context, memoryID string, feedback FeedbackType, reason string) (*Feedback, error) {
+	return runOperation(c, ctx, OpSubmitFeedback, &SubmitFeedbackRequest{MemoryID: memoryID, Feedback: feedback, Reason: reason}, c.submitFeedback)
+}
+
+func (c *Mem0Client) submitFeedback(ctx context.Context, r *SubmitFeedbackRequest) (*Feedback, error) {
+	if r == nil {
+		return nil, fmt.Errorf("submit feedback request cannot be nil")
+	}
+	memoryID, feedback, reason := r.MemoryID, r.Feedback, r.Reason
+
+	if memoryID == "" {
+		return nil, fmt.Errorf("memory id is required")
+	}
+	switch feedback {
+	case FeedbackPositive, FeedbackNegative, FeedbackVeryNegative:
+	default:
+		return nil, fmt.Errorf("invalid feedback: %s", feedback)
+	}
+
+	payload := feedbackRequest{MemoryID: memoryID, Feedback: feedback}
+	payload.OrgID, payload.ProjectID = c.resolveScope(ctx, "", "")
+	if reason != "" {
+		payload.FeedbackReason = &reason
+	}
+
+	var result Feedback
+	if _, err := c.doJSON(ctx, "POST", c.config.BaseURL+"/feedback/", payload, &result); err != nil {
+		return nil, err
+	}
+
+	// The API may only acknowledge the submission, so fill in what was sent
+	if result.MemoryID == "" {
+		result.MemoryID = memoryID
+	}
+	if result.Feedback == "" {
+		result.Feedback = feedback
+		result.FeedbackReason = payload.FeedbackReason
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 submitted feedback", slog.String("memory_id", memoryID), slog.String("feedback", string(feedback)))
+	return &result, nil
+}
+
+// ListFeedback retrieves the feedback already attached to a memory
+func (c *Mem0Client) ListFeedback(ctx context.Context, memoryID string) ([]Feedback, error) {
+	return runOperation(c, ctx, OpListFeedback, memoryID, c.listFeedback)
+}
+
+func (c *Mem0Client) listFeedback(ctx context.Context, memoryID string) ([]Feedback, error) {
+	if memoryID == "" {
+		return nil, fmt.Errorf("memory id is required")
+	}
+
+	q := url.Values{}
+	q.Add("memory_id", memoryID)
+	c.scopeQuery(ctx, q)
+
+	var raw json.RawMessage
+	if _, err := c.doJSON(ctx, "GET", c.config.BaseURL+"/feedback/?"+q.Encode(), nil, &raw); err != nil {
+		return nil, err
+	}
+
+	var feedback []Feedback
+	if err := decodeResultList(raw, &feedback); err != nil {
+		return nil, err
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 listed feedback", slog.String("memory_id", memoryID), slog.Int("count", len(feedback)))
+	return feedback, nil
+}
@@ -0,0 +1,287 @@
+package mem0client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Logical operators of a v2 filter expression
+const (
+	filterAnd = "AND"
+	filterOr  = "OR"
+	filterNot = "NOT"
+)
+
+// Comparison operators of a v2 filter expression. An empty operator means equality.
+const (
+	opEq        = ""
+	opNe        = "ne"
+	opIn        = "in"
+	opGt        = "gt"
+	opGte       = "gte"
+	opLt        = "lt"
+	opLte       = "lte"
+	opContains  = "contains"
+	opIContains = "icontains"
+)
+
+// metadataPrefix marks a field as a metadata key, e.g. "metadata.source"
+const metadataPrefix = "metadata."
+
+// filterFields lists the top level fields the v2 API accepts in a filter
+var filterFields = map[string]bool{
+	"user_id":    true,
+	"agent_id":   true,
+	"app_id":     true,
+	"run_id":     true,
+	"created_at": true,
+	"updated_at": true,
+	"categories": true,
+	"keywords":   true,
+	"memory_ids": true,
+}
+
+type filterCondition struct {
+	op    string
+	value interface{}
+}
+
+// Filter is a node of a v2 filter expression. Build one with And, Or, Not and
+// the comparison helpers; the zero value is an empty, invalid filter.
+type Filter struct {
+	logic      string
+	children   []Filter
+	field      string
+	conditions []filterCondition
+}
+
+// And matches memories that satisfy every sub filter
+func And(filters ...Filter) Filter {
+	return Filter{logic: filterAnd, children: filters}
+}
+
+// Or matches memories that satisfy at least one sub filter
+func Or(filters ...Filter) Filter {
+	return Filter{logic: filterOr, children: filters}
+}
+
+// Not matches memories that satisfy none of the sub filters
+func Not(filters ...Filter) Filter {
+	return Filter{logic: filterNot, children: filters}
+}
+
+func leaf(field, op string, value interface{}) Filter {
+	return Filter{field: field, conditions: []filterCondition{{op: op, value: value}}}
+}
+
+// Eq matches memories whose field equals value. Use "metadata.<key>" to compare a metadata key.
+func Eq(field string, value interface{}) Filter { return leaf(field, opEq, value) }
+
+// Ne matches memories whose field differs from value
+func Ne(field string, value interface{}) Filter { return leaf(field, opNe, value) }
+
+// In matches memories whose field equals any of values
+func In(field string, values ...interface{}) Filter { return leaf(field, opIn, values) }
+
+// Gt matches memories whose field is greater than value
+func Gt(field string, value interface{}) Filter { return leaf(field, opGt, value) }
+
+// Gte matches memories whose field is greater than or equal to value
+func Gte(field string, value interface{}) Filter { return leaf(field, opGte, value) }
+
+// Lt matches memories whose field is less than value
+func Lt(field string, value interface{}) Filter { return leaf(field, opLt, value) }
+
+// Lte matches memories whose field is less than or equal to value
+func Lte(field string, value interface{}) Filter { return leaf(field, opLte, value) }
+
+// Contains matches memories whose field contains value
+func Contains(field string, value interface{}) Filter { return leaf(field, opContains, value) }
+
+// IContains matches memories whose field contains value, ignoring case
+func IContains(field string, value interface{}) Filter { return leaf(field, opIContains, value) }
+
+// Between matches memories whose field lies in the inclusive range [from, to]
+func Between(field string, from, to interface{}) Filter {
+	return Filter{field: field, conditions: []filterCondition{{op: opGte, value: from}, {op: opLte, value: to}}}
+}
+
+// UserIDIs matches memories owned by the given user
+func UserIDIs(userID string) Filter { return Eq("user_id", userID) }
+
+// AgentIDIs matches memories owned by the given agent
+func AgentIDIs(agentID string) Filter { return Eq("agent_id", agentID) }
+
+// AppIDIs matches memories owned by the given app
+func AppIDIs(appID string) Filter { return Eq("app_id", appID) }
+
+// RunIDIs matches memories owned by the given run
+func RunIDIs(runID string) Filter { return Eq("run_id", runID) }
+
+// CreatedBetween matches memories created in the inclusive range [from, to]
+func CreatedBetween(from, to time.Time) Filter { return Between("created_at", from, to) }
+
+// UpdatedBetween matches memories updated in the inclusive range [from, to]
+func UpdatedBetween(from, to time.Time) Filter { return Between("updated_at", from, to) }
+
+// CategoriesIn matches memories tagged with any of the given categories
+func CategoriesIn(categories ...string) Filter {
+	values := make([]interface{}, len(categories))
+	for i, cat := range categories {
+		values[i] = cat
+	}
+	return In("categories", values...)
+}
+
+// CategoriesContain matches memories whose categories contain the given category
+func CategoriesContain(category string) Filter { return Contains("categories", category) }
+
+// MetadataEq matches memories whose metadata key equals value
+func MetadataEq(key string, value interface{}) Filter { return Eq(metadataPrefix+key, value) }
+
+// IsZero reports whether the filter is empty
+func (f Filter) IsZero() bool {
+	return f.logic == "" && f.field == "" && len(f.children) == 0 && len(f.conditions) == 0
+}
+
+// Validate checks the expression for malformed nodes before it is sent to the API
+func (f Filter) Validate() error {
+	if f.IsZero() {
+		return fmt.Errorf("invalid filter: empty expression")
+	}
+
+	if f.logic != "" {
+		if len(f.children) == 0 {
+			return fmt.Errorf("invalid filter: %s requires at least one sub filter", f.logic)
+		}
+		for i, child := range f.children {
+			if err := child.Validate(); err != nil {
+				return fmt.Errorf("%s[%d]: %w", f.logic, i, err)
+			}
+		}
+		return nil
+	}
+
+	return f.validateLeaf()
+}
+
+func (f Filter) validateLeaf() error {
+	isMetadata := strings.HasPrefix(f.field, metadataPrefix)
+	switch {
+	case f.field == "":
+		return fmt.Errorf("invalid filter: field is required")
+	case isMetadata && len(f.field) == len(metadataPrefix):
+		return fmt.Errorf("invalid filter: metadata key is required")
+	case !isMetadata && !filterFields[f.field]:
+		return fmt.Errorf("invalid filter: unknown field %q", f.field)
+	case len(f.conditions) == 0:
+		return fmt.Errorf("invalid filter: %s has no condition", f.field)
+	}
+
+	for _, cond := range f.conditions {
+		if cond.value == nil {
+			return fmt.Errorf("invalid filter: %s value cannot be nil", f.field)
+		}
+
+		switch cond.op {
+		case opEq, opNe:
+			if f.field == "created_at" || f.field == "updated_at" {
+				if err := validateFilterTime(f.field, cond.value); err != nil {
+					return err
+				}
+			}
+		case opIn:
+			values, ok := cond.value.([]interface{})
+			if !ok || len(values) == 0 {
+				return fmt.Errorf("invalid filter: %s in requires at least one value", f.field)
+			}
+		case opGt, opGte, opLt, opLte:
+			switch {
+			case f.field == "created_at" || f.field == "updated_at":
+				if err := validateFilterTime(f.field, cond.value); err != nil {
+					return err
+				}
+			case isMetadata:
+				// metadata values may be compared as numbers or strings
+			default:
+				return fmt.Errorf("invalid filter: %s does not support %s", f.field, cond.op)
+			}
+		case opContains, opIContains:
+			if _, ok := cond.value.(string); !ok {
+				return fmt.Errorf("invalid filter: %s %s requires a string value", f.field, cond.op)
+			}
+		default:
+			return fmt.Errorf("invalid filter: unknown operator %q", cond.op)
+		}
+	}
+
+	return nil
+}
+
+// validateFilterTime ensures a date field is compared against a time or a date string
+func validateFilterTime(field string, value interface{}) error {
+	switch v := value.(type) {
+	case time.Time:
+		if v.IsZero() {
+			return fmt.Errorf("invalid filter: %s requires a non zero time", field)
+		}
+	case string:
+		if _, err := time.Parse(time.RFC3339, v); err == nil {
+			return nil
+		}
+		if _, err := time.Parse(time.DateOnly, v); err != nil {
+			return fmt.Errorf("invalid filter: %s value %q is not a date or RFC3339 time", field, v)
+		}
+	default:
+		return fmt.Errorf("invalid filter: %s requires a time.Time or date string", field)
+	}
+	return nil
+}
+
+func filterValue(value interface{}) interface{} {
+	if t, ok := value.(time.Time); ok {
+		return t.UTC().Format(time.RFC3339)
+	}
+	return value
+}
+
+// MarshalJSON serializes the expression to the v2 filters JSON format
+func (f Filter) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.toJSON())
+}
+
+func (f Filter) toJSON() map[string]interface{} {
+	if f.logic != "" {
+		children := make([]interface{}, len(f.children))
+		for i, child := range f.children {
+			children[i] = child.toJSON()
+		}
+		return map[string]interface{}{f.logic: children}
+	}
+
+	var value interface{}
+	if len(f.conditions) == 1 && f.conditions[0].op == opEq {
+		value = filterValue(f.conditions[0].value)
+	} else {
+		ops := make(map[string]interface{}, len(f.conditions))
+		for _, cond := range f.conditions {
+			ops[cond.op] = filterValue(cond.value)
+		}
+		value = ops
+	}
+
+	if key, ok := strings.CutPrefix(f.field, metadataPrefix); ok {
+		return map[string]interface{}{"metadata": map[string]interface{}{key: value}}
+	}
+	return map[string]interface{}{f.field: value}
+}
+
+// rootFilter wraps a bare comparison in AND, since the API expects a logical operator at the root
+func rootFilter(f Filter) Filter {
+	if f.logic == "" {
+		return And(f)
+	}
+	return f
+}
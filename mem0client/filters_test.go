@@ -0,0 +1,84 @@
+package mem0client
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFilterJSON(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 30, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   string
+	}{
+		{"equality", UserIDIs("alex"), `{"user_id":"alex"}`},
+		{"and", And(UserIDIs("alex"), AgentIDIs("support")),
+			`{"AND":[{"user_id":"alex"},{"agent_id":"support"}]}`},
+		{"or", Or(RunIDIs("run-1"), AppIDIs("app-1")),
+			`{"OR":[{"run_id":"run-1"},{"app_id":"app-1"}]}`},
+		{"not", Not(CategoriesContain("work")),
+			`{"NOT":[{"categories":{"contains":"work"}}]}`},
+		{"between on created_at", CreatedBetween(from, to),
+			`{"created_at":{"gte":"2024-01-01T00:00:00Z","lte":"2024-06-30T10:00:00Z"}}`},
+		{"between with date strings", Between("updated_at", "2024-01-01", "2024-02-01"),
+			`{"updated_at":{"gte":"2024-01-01","lte":"2024-02-01"}}`},
+		{"categories in", CategoriesIn("food", "travel"), `{"categories":{"in":["food","travel"]}}`},
+		{"metadata equality", MetadataEq("source", "email"), `{"metadata":{"source":"email"}}`},
+		{"metadata comparison", Gte("metadata.priority", 3), `{"metadata":{"priority":{"gte":3}}}`},
+		{"nested", And(UserIDIs("alex"), Or(Ne("metadata.source", "chat"), Not(IContains("keywords", "Tea")))),
+			`{"AND":[{"user_id":"alex"},{"OR":[{"metadata":{"source":{"ne":"chat"}}},{"NOT":[{"keywords":{"icontains":"Tea"}}]}]}]}`},
+		{"root wraps a comparison", rootFilter(UserIDIs("alex")), `{"AND":[{"user_id":"alex"}]}`},
+		{"root keeps logic", rootFilter(Or(UserIDIs("alex"))), `{"OR":[{"user_id":"alex"}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.filter.Validate(); err != nil {
+				t.Fatalf("Validate() = %v", err)
+			}
+			got, err := json.Marshal(tt.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  Filter
+		wantErr string
+	}{
+		{"zero filter", Filter{}, "empty expression"},
+		{"empty and", And(), "AND requires at least one sub filter"},
+		{"empty or", Or(), "OR requires at least one sub filter"},
+		{"empty nested logic", And(UserIDIs("alex"), Not()), "AND[1]: invalid filter: NOT requires at least one sub filter"},
+		{"unknown field", Eq("owner", "alex"), `unknown field "owner"`},
+		{"missing field", Eq("", "alex"), "field is required"},
+		{"missing metadata key", Eq("metadata.", "x"), "metadata key is required"},
+		{"range on user_id", Gt("user_id", "alex"), "user_id does not support gt"},
+		{"bad date string", Between("created_at", "2024-01-01", "last week"), `created_at value "last week" is not a date`},
+		{"zero time", Gte("updated_at", time.Time{}), "updated_at requires a non zero time"},
+		{"number for a date", Eq("created_at", 1704067200), "created_at requires a time.Time or date string"},
+		{"empty in", In("categories"), "categories in requires at least one value"},
+		{"empty categories in", CategoriesIn(), "categories in requires at least one value"},
+		{"nil value", Eq("agent_id", nil), "agent_id value cannot be nil"},
+		{"contains with a number", Contains("keywords", 3), "keywords contains requires a string value"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package mem0client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Relation is a source–relationship–target edge extracted by graph memory,
+// e.g. alex -[works_for]-> acme
+type Relation struct {
+	Source       string `json:"source"`
+	SourceType   string `json:"source_type,omitempty"`
+	Relationship string `json:"relationship"`
+	Target       string `json:"target"`
+	TargetType   string `json:"target_type,omitempty"`
+}
+
+// UnmarshalJSON accepts "destination" as an alias of "target", which some endpoints use
+func (r *Relation) UnmarshalJSON(data []byte) error {
+	var wire struct {
+		Source          string `json:"source"`
+		SourceType      string `json:"source_type"`
+		Relationship    string `json:"relationship"`
+		Relation        string `json:"relation"`
+		Target          string `json:"target"`
+		TargetType      string `json:"target_type"`
+		Destination     string `json:"destination"`
+		DestinationType string `json:"destination_type"`
+	}
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+
+	*r = Relation{
+		Source:       wire.Source,
+		SourceType:   wire.SourceType,
+		Relationship: wire.Relationship,
+		Target:       wire.Target,
+		TargetType:   wire.TargetType,
+	}
+	if r.Relationship == "" {
+		r.Relationship = wire.Relation
+	}
+	if r.Target == "" {
+		r.Target = wire.Destination
+	}
+	if r.TargetType == "" {
+		r.TargetType = wire.DestinationType
+	}
+	return nil
+}
+
+// Involves reports whether entity is the source or the target of the relation, ignoring case
+func (r Relation) Involves(entity string) bool {
+	return strings.EqualFold(r.Source, entity) || strings.EqualFold(r.Target, entity)
+}
+
+// decodeAddRelations decodes the relations of an add response, which are reported as
+// {"added_entities": [...], "deleted_entities": [...]} with each side either a flat
+// list of relations or a list of lists
+func decodeAddRelations(raw json.RawMessage) (added, deleted []Relation, err error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil, nil
+	}
+
+	var wire struct {
+		Added   json.RawMessage `json:"added_entities"`
+		Deleted json.RawMessage `json:"deleted_entities"`
+	}
+	if err := json.Unmarshal(raw, &wire); err != nil {
+		// Some responses carry a plain list of relations instead
+		var list []Relation
+		if listErr := json.Unmarshal(raw, &list); listErr != nil {
+			return nil, nil, fmt.Errorf("failed to decode relations: %v", err)
+		}
+		return list, nil, nil
+	}
+
+	if added, err = decodeRelationList(wire.Added); err != nil {
+		return nil, nil, err
+	}
+	if deleted, err = decodeRelationList(wire.Deleted); err != nil {
+		return nil, nil, err
+	}
+	return added, deleted, nil
+}
+
+func decodeRelationList(raw json.RawMessage) ([]Relation, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var flat []Relation
+	if err := json.Unmarshal(raw, &flat); err == nil {
+		return flat, nil
+	}
+
+	var nested [][]Relation
+	if err := json.Unmarshal(raw, &nested); err != nil {
+		return nil, fmt.Errorf("failed to decode relations: %v", err)
+	}
+	var relations []Relation
+	for _, group := range nested {
+		relations = append(relations, group...)
+	}
+	return relations, nil
+}
+
+// GetRelationsOptions scopes the graph relations returned by GetRelations
+type GetRelationsOptions struct {
+	UserID    string
+	AgentID   string
+	AppID     string
+	RunID     string
+	OrgID     string
+	ProjectID string
+	// Entity keeps only relations whose source or target is this entity, ignoring case
+	Entity string
+}
+
+// GetRelations retrieves the graph relations extracted for the given scope
+func (c *Mem0Client) GetRelations(ctx context.Context, opts *GetRelationsOptions) ([]Relation, error) {
+	return runOperation(c, ctx, OpGetRelations, opts, c.getRelations)
+}
+
+func (c *Mem0Client) getRelations(ctx context.Context, opts *GetRelationsOptions) ([]Relation, error) {
+	if opts == nil || (opts.UserID == "" && opts.AgentID == "" && opts.AppID == "" && opts.RunID == "") {
+		return nil, fmt.Errorf("one of the following is required: user_id, agent_id, app_id or run_id")
+	}
+
+	page, err := c.getMemoriesPage(ctx, &GetMemoriesOptions{
+		UserID:      opts.UserID,
+		AgentID:     opts.AgentID,
+		AppID:       opts.AppID,
+		RunID:       opts.RunID,
+		OrgID:       opts.OrgID,
+		ProjectID:   opts.ProjectID,
+		EnableGraph: true,
+	}, OutputFormatV11)
+	if err != nil {
+		return nil, err
+	}
+
+	relations := page.Relations
+	if opts.Entity != "" {
+		relations = nil
+		for _, r := range page.Relations {
+			if r.Involves(opts.Entity) {
+				relations = append(relations, r)
+			}
+		}
+	}
+
+	return relations, nil
+}
@@ -0,0 +1,57 @@
+package mem0client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// MemoryEvent is the kind of change applied to a memory
+type MemoryEvent string
+
+const (
+	MemoryEventAdd    MemoryEvent = "ADD"
+	MemoryEventUpdate MemoryEvent = "UPDATE"
+	MemoryEventDelete MemoryEvent = "DELETE"
+	MemoryEventNoop   MemoryEvent = "NOOP"
+)
+
+// HistoryEvent represents a single change in the audit trail of a memory
+type HistoryEvent struct {
+	ID         string      `json:"id"`
+	MemoryID   string      `json:"memory_id"`
+	Event      MemoryEvent `json:"event"`
+	OldMemory  *string     `json:"old_memory"`
+	NewMemory  *string     `json:"new_memory"`
+	Input      []Message   `json:"input"`
+	UserID     string      `json:"user_id,omitempty"`
+	Categories []string    `json:"categories,omitempty"`
+	Metadata   Metadata    `json:"metadata,omitempty"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+// History retrieves the full list of changes applied to a memory, oldest first
+func (c *Mem0Client) History(ctx context.Context, memoryID string) ([]HistoryEvent, error) {
+	return runOperation(c, ctx, OpHistory, memoryID, c.history)
+}
+
+func (c *Mem0Client) history(ctx context.Context, memoryID string) ([]HistoryEvent, error) {
+	if memoryID == "" {
+		return nil, fmt.Errorf("memory id is required")
+	}
+
+	var events []HistoryEvent
+	reqURL := c.scopedURL(ctx, fmt.Sprintf("%s/memories/%s/history/", c.config.BaseURL, url.PathEscape(memoryID)))
+	status, err := c.doJSON(ctx, "GET", reqURL, nil, &events)
+	if status == http.StatusNotFound {
+		return nil, &MemoryNotFoundError{MemoryID: memoryID, Err: err}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
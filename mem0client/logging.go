@@ -0,0 +1,200 @@
+package mem0client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// redactedValue replaces masked values in log records
+const redactedValue = "[REDACTED]"
+
+// contentKeys are JSON fields that carry conversation or memory text
+var contentKeys = map[string]bool{
+	"content":         true,
+	"memory":          true,
+	"old_memory":      true,
+	"new_memory":      true,
+	"previous_memory": true,
+	"text":            true,
+	"query":           true,
+}
+
+// secretKeys are JSON fields that carry credentials; they are always masked
+var secretKeys = map[string]bool{
+	"secret": true,
+}
+
+// RedactionPolicy controls what is masked in log records. The API key and webhook
+// secrets are always masked.
+type RedactionPolicy struct {
+	// RevealContent logs message content, memory text and search queries as is.
+	// They are masked by default.
+	RevealContent bool
+	// MetadataKeys lists metadata keys whose values are masked
+	MetadataKeys []string
+	// Levels replaces the policy for records at the given levels, e.g. to reveal
+	// content in debug records only. Levels of the replacement policies are ignored.
+	Levels map[slog.Level]RedactionPolicy
+}
+
+// forLevel returns the policy that applies to records at level
+func (p RedactionPolicy) forLevel(level slog.Level) RedactionPolicy {
+	if override, ok := p.Levels[level]; ok {
+		return override
+	}
+	return p
+}
+
+// WithLogger sends structured log records to logger. Logging is off by default;
+// which records are written is decided by the level of the logger's handler:
+// requests and payloads are logged at debug, completed operations at debug,
+// retries and failed operations at warn.
+func WithLogger(logger *slog.Logger) func(*Mem0ClientConfig) {
+	return func(c *Mem0ClientConfig) {
+		c.Logger = logger
+	}
+}
+
+// WithRedaction sets the redaction policy applied to log records
+func WithRedaction(policy RedactionPolicy) func(*Mem0ClientConfig) {
+	return func(c *Mem0ClientConfig) {
+		c.Redaction = policy
+	}
+}
+
+// newLogger returns the configured logger, a debug logger writing to stderr when
+// Debug is set without one, or nil when logging is off
+func newLogger(config Mem0ClientConfig) *slog.Logger {
+	if config.Logger != nil {
+		return config.Logger
+	}
+	if config.Debug {
+		return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	}
+	return nil
+}
+
+// log writes a record when a logger is configured and enabled for level
+func (c *Mem0Client) log(ctx context.Context, level slog.Level, msg string, args ...any) {
+	if c.logger == nil || !c.logger.Enabled(ctx, level) {
+		return
+	}
+	c.logger.Log(ctx, level, msg, args...)
+}
+
+// logEnabled reports whether records at level are written, to skip building costly attributes
+func (c *Mem0Client) logEnabled(ctx context.Context, level slog.Level) bool {
+	return c.logger != nil && c.logger.Enabled(ctx, level)
+}
+
+// redactBody returns a JSON body logged at level with secrets, content, configured
+// metadata keys and the API key masked according to the redaction policy
+func (c *Mem0Client) redactBody(level slog.Level, body []byte) string {
+	policy := c.config.Redaction.forLevel(level)
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		if !policy.RevealContent {
+			// Without structure there is no telling what the body contains
+			return redactedValue
+		}
+		return c.redactAPIKey(string(body))
+	}
+
+	redacted, err := json.Marshal(policy.redactValue(value, false))
+	if err != nil {
+		return redactedValue
+	}
+	return c.redactAPIKey(string(redacted))
+}
+
+// redactValue walks a decoded JSON value; inMetadata is set inside metadata objects
+func (p RedactionPolicy) redactValue(value interface{}, inMetadata bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			switch {
+			case secretKeys[key]:
+				v[key] = redactedValue
+			case inMetadata && p.isRedactedMetadataKey(key):
+				v[key] = redactedValue
+			case !inMetadata && contentKeys[key] && !p.RevealContent:
+				v[key] = redactedValue
+			default:
+				v[key] = p.redactValue(field, inMetadata || key == "metadata")
+			}
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = p.redactValue(item, inMetadata)
+		}
+		return v
+	}
+	return value
+}
+
+func (p RedactionPolicy) isRedactedMetadataKey(key string) bool {
+	for _, k := range p.MetadataKeys {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// minRedactedKeyLength is the shortest API key masked in free text; shorter keys
+// would match unrelated text and are too weak to be worth hiding anyway
+const minRedactedKeyLength = 8
+
+// redactAPIKey masks the API key wherever it appears in s
+func (c *Mem0Client) redactAPIKey(s string) string {
+	key := strings.TrimPrefix(c.config.APIKey, "Token ")
+	if len(key) < minRedactedKeyLength {
+		return s
+	}
+	return strings.ReplaceAll(s, key, redactedValue)
+}
+
+// errorAttr returns err as a log attribute without the query of the request URL,
+// which carries user IDs, keywords and metadata filters, or the API key
+func (c *Mem0Client) errorAttr(err error) slog.Attr {
+	msg := err.Error()
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		stripped := &url.Error{Op: urlErr.Op, Err: urlErr.Err}
+		if u, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+			stripped.URL = (&url.URL{Scheme: u.Scheme, Host: u.Host, Path: u.Path}).String()
+		}
+		msg = strings.Replace(msg, urlErr.Error(), stripped.Error(), 1)
+	}
+	return slog.String("error", c.redactAPIKey(msg))
+}
+
+// resultCount returns the number of items in an operation result, if it is a collection
+func resultCount(result interface{}) (int, bool) {
+	switch r := result.(type) {
+	case nil:
+		return 0, false
+	case *AddResult:
+		if r == nil {
+			return 0, false
+		}
+		return len(r.Events), true
+	case *SearchResult:
+		if r == nil {
+			return 0, false
+		}
+		return len(r.Memories), true
+	}
+	if v := reflect.ValueOf(result); v.Kind() == reflect.Slice {
+		return v.Len(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,131 @@
+package mem0client
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	body := []byte(`{"query": "what do I like", "secret": "whsec-1", "metadata": {"email": "a@example.com", "secret": "s"}, "key": "Token test-key"}`)
+
+	tests := []struct {
+		name   string
+		policy RedactionPolicy
+		level  slog.Level
+		want   []string
+		absent []string
+	}{
+		{"default", RedactionPolicy{}, slog.LevelDebug,
+			[]string{`"query":"[REDACTED]"`, `"email":"a@example.com"`}, []string{"whsec-1", `"s"`, "test-key"}},
+		{"reveal content", RedactionPolicy{RevealContent: true, MetadataKeys: []string{"Email"}}, slog.LevelDebug,
+			[]string{`"query":"what do I like"`, `"email":"[REDACTED]"`}, []string{"whsec-1", "test-key"}},
+		{"reveal at debug only, debug record", RedactionPolicy{Levels: map[slog.Level]RedactionPolicy{slog.LevelDebug: {RevealContent: true}}}, slog.LevelDebug,
+			[]string{`"query":"what do I like"`}, []string{"whsec-1", "test-key"}},
+		{"reveal at debug only, warn record", RedactionPolicy{Levels: map[slog.Level]RedactionPolicy{slog.LevelDebug: {RevealContent: true}}}, slog.LevelWarn,
+			[]string{`"query":"[REDACTED]"`}, []string{"whsec-1", "test-key"}},
+		{"stricter at warn", RedactionPolicy{RevealContent: true, Levels: map[slog.Level]RedactionPolicy{slog.LevelWarn: {}}}, slog.LevelWarn,
+			[]string{`"query":"[REDACTED]"`}, []string{"what do I like"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewMem0Client("test-key", WithRedaction(tt.policy))
+			got := c.redactBody(tt.level, bytes.Clone(body))
+			for _, s := range tt.want {
+				if !strings.Contains(got, s) {
+					t.Errorf("redacted body %s does not contain %s", got, s)
+				}
+			}
+			for _, s := range tt.absent {
+				if strings.Contains(got, s) {
+					t.Errorf("redacted body %s reveals %s", got, s)
+				}
+			}
+		})
+	}
+}
+
+type requestIDKey struct{}
+
+// contextHandler records the request ID carried by the context of every record
+type contextHandler struct {
+	slog.Handler
+	ids *[]string
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Message == "mem0 error response" {
+		id, _ := ctx.Value(requestIDKey{}).(string)
+		*h.ids = append(*h.ids, id)
+	}
+	return nil
+}
+
+func TestErrorResponseLoggedWithRequestContext(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"detail": "boom"}`, http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	var ids []string
+	handler := contextHandler{Handler: slog.NewTextHandler(nil, &slog.HandlerOptions{Level: slog.LevelDebug}), ids: &ids}
+	c := NewMem0Client("test-key", WithBaseURL(srv.URL), WithLogger(slog.New(handler)))
+
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "req-42")
+	if _, err := c.GetMemory(ctx, "mem-1"); err == nil {
+		t.Fatal("expected the 500 response to fail")
+	}
+	if len(ids) != 1 || ids[0] != "req-42" {
+		t.Errorf("error response logged with request IDs %q, want the caller's context", ids)
+	}
+}
+
+func TestTransportErrorLoggedWithoutQuery(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	baseURL := srv.URL
+	srv.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	c := NewMem0Client("test-api-key-0123", WithBaseURL(baseURL), WithLogger(logger),
+		WithRetryPolicy(fastRetries), WithRedaction(RedactionPolicy{MetadataKeys: []string{"email"}}))
+
+	_, err := c.GetMemories(context.Background(), &GetMemoriesOptions{
+		UserID:   "alex",
+		Keywords: "allergies",
+		Metadata: map[string]string{"email": "alex@example.com"},
+	})
+	if err == nil {
+		t.Fatal("expected the request to a closed port to fail")
+	}
+
+	logged := buf.String()
+	if !strings.Contains(logged, "mem0 request failed, retrying") || !strings.Contains(logged, "mem0 operation failed") {
+		t.Fatalf("missing failure records in:\n%s", logged)
+	}
+	for _, s := range []string{"alex", "allergies", "example.com", "test-api-key-0123"} {
+		if strings.Contains(logged, s) {
+			t.Errorf("log reveals %q:\n%s", s, logged)
+		}
+	}
+}
+
+func TestRedactAPIKey(t *testing.T) {
+	tests := []struct {
+		key, s, want string
+	}{
+		{"m0-0123456789abcdef", "token m0-0123456789abcdef rejected", "token [REDACTED] rejected"},
+		{"Token m0-0123456789abcdef", "token m0-0123456789abcdef rejected", "token [REDACTED] rejected"},
+		{"k", "?keywords=tea", "?keywords=tea"},
+	}
+	for _, tt := range tests {
+		c := NewMem0Client(tt.key)
+		if got := c.redactAPIKey(tt.s); got != tt.want {
+			t.Errorf("redactAPIKey(%q) with key %q = %q, want %q", tt.s, tt.key, got, tt.want)
+		}
+	}
+}
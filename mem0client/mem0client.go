@@ -6,17 +6,32 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"sort"
 	"strings"
 	"time"
 )
 
-// Mem0Error represents specific errors from the Mem0 API
+// Mem0Error represents specific errors from the Mem0 API. It matches the sentinel
+// errors such as ErrNotFound and ErrServer with errors.Is according to StatusCode.
 type Mem0Error struct {
 	Detail   string         `json:"detail"`
 	Code     string         `json:"code"`
 	Messages []ErrorMessage `json:"messages"`
+	// StatusCode is the HTTP status of the response
+	StatusCode int `json:"-"`
+	// RequestID is the ID the API assigned to the request, if it reported one
+	RequestID string `json:"-"`
+	// Op is the client operation that failed, e.g. "Store", when known
+	Op string `json:"-"`
+	// Endpoint is the method and path of the failed request, e.g. "POST /v1/memories/"
+	Endpoint string `json:"-"`
+	// RetryAfter is the delay requested by the Retry-After header, if any
+	RetryAfter time.Duration `json:"-"`
+	// Fields holds the field-level validation errors of 400 and 422 responses, by field name
+	Fields map[string][]string `json:"-"`
 }
 
 type ErrorMessage struct {
@@ -37,9 +52,33 @@ func (e *Mem0Error) Error() string {
 			e.Messages[0].TokenClass)
 	}
 
+	if len(e.Fields) > 0 {
+		names := make([]string, 0, len(e.Fields))
+		for name := range e.Fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Sprintf("Mem0 API Error: invalid %s: %s", names[0], strings.Join(e.Fields[names[0]], "; "))
+	}
+
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("Mem0 API Error: HTTP %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+
 	return "Unknown Mem0 API Error"
 }
 
+// MemoryNotFoundError is returned when a memory ID does not exist. It matches ErrNotFound.
+type MemoryNotFoundError struct {
+	MemoryID string
+	// Err is the underlying API error, if any
+	Err error
+}
+
+func (e *MemoryNotFoundError) Error() string {
+	return fmt.Sprintf("Mem0 API Error: memory %s not found", e.MemoryID)
+}
+
 // Memory represents a single memory entry
 type Memory struct {
 	ID        string    `json:"id"`
@@ -49,16 +88,17 @@ type Memory struct {
 }
 
 type ResponseSingleMemory struct {
-	ID        string    `json:"id"`
-	Memory    string    `json:"memory"`
-	UserID    string    `json:"user_id"`
-	AgentID   *string   `json:"agent_id,omitempty"`
-	AppID     *string   `json:"app_id,omitempty"`
-	RunID     *string   `json:"run_id,omitempty"`
-	Hash      string    `json:"hash"`
-	Metadata  Metadata  `json:"metadata"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID         string    `json:"id"`
+	Memory     string    `json:"memory"`
+	UserID     string    `json:"user_id"`
+	AgentID    *string   `json:"agent_id,omitempty"`
+	AppID      *string   `json:"app_id,omitempty"`
+	RunID      *string   `json:"run_id,omitempty"`
+	Hash       string    `json:"hash"`
+	Metadata   Metadata  `json:"metadata"`
+	Categories []string  `json:"categories,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
 }
 
 type ResponseSearchMemories struct {
@@ -90,19 +130,37 @@ type Metadata map[string]interface{}
 
 // Mem0ClientConfig allows customization of the Mem0 client
 type Mem0ClientConfig struct {
-	BaseURL        string
-	HTTPClient     *http.Client
-	APIKey         string
-	Debug          bool
+	BaseURL    string
+	HTTPClient *http.Client
+	APIKey     string
+	// Debug logs debug records to stderr when no Logger is set
+	Debug bool
+	// Logger receives structured log records; logging is off when nil, see WithLogger
+	Logger *slog.Logger
+	// Redaction controls what is masked in log records, see WithRedaction
+	Redaction      RedactionPolicy
 	UserID         string
 	OrganizationID string
 	ProjectID      string
+	// RetryPolicy enables automatic retries when set, see WithRetryPolicy
+	RetryPolicy *RetryPolicy
+	// RateLimiter throttles requests when set, see WithRateLimiter
+	RateLimiter *RateLimiter
+	// Middlewares wrap every client call, see WithMiddleware
+	Middlewares []Middleware
+	// Tracer traces every client call when set, see WithTracer
+	Tracer Tracer
+	// Metrics records request metrics when set, see WithMetrics
+	Metrics *Metrics
+	// CircuitBreaker rejects requests while the API keeps failing when set, see WithCircuitBreaker
+	CircuitBreaker *CircuitBreaker
 	version        string
 }
 
 // Mem0Client is the main client for interacting with memories
 type Mem0Client struct {
 	config Mem0ClientConfig
+	logger *slog.Logger
 }
 
 // NewMem0Client creates a new Mem0 client with default or custom configurations
@@ -119,7 +177,6 @@ func NewMem0Client(apiKey string, opts ...func(*Mem0ClientConfig)) *Mem0Client {
 			Timeout: 10 * time.Second,
 		},
 		APIKey:  apiKey,
-		Debug:   true,
 		version: "v1.1",
 		// UserID:         userID,
 		// OrganizationID: os.Getenv("MEM0_ORG_ID"),
@@ -131,7 +188,7 @@ func NewMem0Client(apiKey string, opts ...func(*Mem0ClientConfig)) *Mem0Client {
 		opt(&config)
 	}
 
-	return &Mem0Client{config: config}
+	return &Mem0Client{config: config, logger: newLogger(config)}
 }
 
 // WithBaseURL allows customizing the base API URL
@@ -148,7 +205,7 @@ func WithHTTPClient(client *http.Client) func(*Mem0ClientConfig) {
 	}
 }
 
-// WithDebug enables debug logging
+// WithDebug logs debug records to stderr unless a logger is set with WithLogger
 func WithDebug(debug bool) func(*Mem0ClientConfig) {
 	return func(c *Mem0ClientConfig) {
 		c.Debug = debug
@@ -176,11 +233,41 @@ func WithProjectID(projectID string) func(*Mem0ClientConfig) {
 	}
 }
 
-// debugLog prints debug information if debug mode is enabled
-func (c *Mem0Client) debugLog(format string, v ...interface{}) {
-	if c.config.Debug {
-		log.Printf("\033[33m"+format+"\033[0m", v...)
+// baseURLFor returns the base URL pointed at another API version, e.g. "v2"
+// for endpoints that only exist on the newer API
+func (c *Mem0Client) baseURLFor(version string) string {
+	return c.rootURL() + "/" + version
+}
+
+// rootURL returns the base URL without its trailing API version segment, for
+// endpoints such as /api/v1/orgs/ that live outside the versioned API
+func (c *Mem0Client) rootURL() string {
+	base := strings.TrimSuffix(c.config.BaseURL, "/")
+	if i := strings.LastIndex(base, "/"); i >= 0 && isVersionSegment(base[i+1:]) {
+		return base[:i]
+	}
+	return base
+}
+
+// derefString returns the value of s, or an empty string when s is nil
+func derefString(s *string) string {
+	if s == nil {
+		return ""
 	}
+	return *s
+}
+
+// isVersionSegment reports whether a URL path segment looks like "v1" or "v2"
+func isVersionSegment(segment string) bool {
+	if len(segment) < 2 || segment[0] != 'v' {
+		return false
+	}
+	for _, r := range segment[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
 }
 
 // prepareRequest adds common headers and parameters
@@ -192,41 +279,111 @@ func (c *Mem0Client) prepareRequest(req *http.Request) {
 	}
 
 	req.Header.Set("Authorization", authHeader)
-	// req.Header.Set("Mem0-User-ID", c.config.UserID)
+	// Organization and project are sent per endpoint as body fields or query
+	// parameters, see resolveScope
+}
 
-	// if c.config.OrganizationID != "" {
-	// 	req.Header.Set("Mem0-Organization-ID", c.config.OrganizationID)
-	// }
+// parseErrorResponse turns an unsuccessful response into a *Mem0Error, whether or not its body is JSON
+func (c *Mem0Client) parseErrorResponse(resp *http.Response) error {
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read error response: %v", err)
+	}
 
-	// if c.config.ProjectID != "" {
-	// 	req.Header.Set("Mem0-Project-ID", c.config.ProjectID)
-	// }
+	ctx := context.Background()
+	if resp.Request != nil {
+		ctx = resp.Request.Context()
+	}
+	if c.logEnabled(ctx, slog.LevelDebug) {
+		c.log(ctx, slog.LevelDebug, "mem0 error response", slog.String("body", c.redactBody(slog.LevelDebug, bodyBytes)))
+	}
+
+	apiError := &Mem0Error{}
+	if err := json.Unmarshal(bodyBytes, apiError); err != nil {
+		// Not a Mem0 error object; drop whatever was partially decoded
+		apiError = &Mem0Error{}
+	}
+	apiError.StatusCode = resp.StatusCode
+	apiError.RequestID = resp.Header.Get("X-Request-Id")
+	apiError.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+	if req := resp.Request; req != nil {
+		apiError.Endpoint = req.Method + " " + req.URL.Path
+		if state := operationOf(req.Context()); state != nil {
+			apiError.Op = state.name
+		}
+	}
+
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnprocessableEntity {
+		apiError.Fields = parseValidationFields(bodyBytes)
+	}
+	if apiError.Detail == "" && len(apiError.Messages) == 0 && len(apiError.Fields) == 0 {
+		apiError.Detail = nonJSONErrorDetail(bodyBytes)
+	}
+
+	return apiError
 }
 
-// parseErrorResponse attempts to parse a Mem0 API error response
-func (c *Mem0Client) parseErrorResponse(body io.Reader) error {
-	var apiError Mem0Error
-	bodyBytes, err := io.ReadAll(body)
+// doJSON sends a request to reqURL, marshaling payload as the JSON body when it
+// is not nil and decoding a successful response into out when it is not nil
+func (c *Mem0Client) doJSON(ctx context.Context, method, reqURL string, payload interface{}, out interface{}) (int, error) {
+	var body io.Reader
+	if payload != nil {
+		jsonPayload, err := json.Marshal(payload)
+		if err != nil {
+			return 0, fmt.Errorf("failed to marshal payload: %v", err)
+		}
+		if c.logEnabled(ctx, slog.LevelDebug) {
+			c.log(ctx, slog.LevelDebug, "mem0 request payload", slog.String("body", c.redactBody(slog.LevelDebug, jsonPayload)))
+		}
+		body = bytes.NewBuffer(jsonPayload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, body)
 	if err != nil {
-		return fmt.Errorf("failed to read error response: %v", err)
+		return 0, fmt.Errorf("failed to create request: %v", err)
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if key, ok := ctx.Value(idempotencyKeyContextKey{}).(string); ok && key != "" {
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	c.prepareRequest(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return 0, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, c.parseErrorResponse(resp)
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, fmt.Errorf("failed to read response body: %v", err)
 	}
 
-	// Log the raw error response for debugging
-	c.debugLog("Error response body: %s", string(bodyBytes))
+	if c.logEnabled(ctx, slog.LevelDebug) {
+		c.log(ctx, slog.LevelDebug, "mem0 response payload", slog.String("body", c.redactBody(slog.LevelDebug, respBody)))
+	}
 
-	// Try to parse the error
-	if err := json.Unmarshal(bodyBytes, &apiError); err != nil {
-		// If parsing fails, return the raw response as an error
-		return fmt.Errorf("API error: %s", string(bodyBytes))
+	if out != nil && len(bytes.TrimSpace(respBody)) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return resp.StatusCode, fmt.Errorf("failed to decode response: %v", err)
+		}
 	}
 
-	return &apiError
+	return resp.StatusCode, nil
 }
 
-// Message represents a single message in the memory
+// Message represents a single message in the memory. Content holds plain text;
+// Parts holds typed multimodal content such as images and documents.
 type Message struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
+	Role    string        `json:"role"`
+	Content string        `json:"content"`
+	Parts   []ContentPart `json:"-"`
 }
 
 // StoreOptions represents the full set of options for storing memories
@@ -246,10 +403,23 @@ type StoreOptions struct {
 	ProjectName      *string   `json:"project_name,omitempty"`
 	OrganizationID   *string   `json:"org_id,omitempty"`
 	ProjectID        *string   `json:"project_id,omitempty"`
+	// EnableGraph also extracts graph relations from the messages
+	EnableGraph *bool `json:"enable_graph,omitempty"`
+	// IdempotencyKey is sent as the Idempotency-Key header. Store is only retried
+	// after the request reached the server when it is set.
+	IdempotencyKey string `json:"-"`
+	// AsyncMode queues the add for background processing; the result then only
+	// carries an EventID to pass to GetEvent or WaitForEvent
+	AsyncMode *bool `json:"async_mode,omitempty"`
+}
+
+// Store saves memories to the system with full configuration options and reports
+// what the extraction pipeline did with the messages
+func (c *Mem0Client) Store(ctx context.Context, opts *StoreOptions) (*AddResult, error) {
+	return runOperation(c, ctx, OpStore, opts, c.store)
 }
 
-// Store saves memories to the system with full configuration options
-func (c *Mem0Client) Store(ctx context.Context, opts *StoreOptions) (*ResponseSingleMemory, error) {
+func (c *Mem0Client) store(ctx context.Context, opts *StoreOptions) (*AddResult, error) {
 	if opts == nil {
 		return nil, fmt.Errorf("store options cannot be nil")
 	}
@@ -264,64 +434,61 @@ func (c *Mem0Client) Store(ctx context.Context, opts *StoreOptions) (*ResponseSi
 		return nil, fmt.Errorf("at least one message is required")
 	}
 
-	// Prepare metadata if not already set
-	if opts.Metadata == nil {
-		opts.Metadata = make(Metadata)
-	}
+	// Build the request in a copy so the caller's options can be reused across calls and tenants
+	payload := *opts
 
 	// Add UserID, AgentID, RunID to metadata if not already present
+	payload.Metadata = make(Metadata, len(opts.Metadata)+3)
+	for k, v := range opts.Metadata {
+		payload.Metadata[k] = v
+	}
 	if opts.UserID != "" {
-		opts.Metadata["user_id"] = opts.UserID
+		payload.Metadata["user_id"] = opts.UserID
 	}
 	if opts.AgentID != "" {
-		opts.Metadata["agent_id"] = opts.AgentID
+		payload.Metadata["agent_id"] = opts.AgentID
 	}
 	if opts.RunID != "" {
-		opts.Metadata["run_id"] = opts.RunID
+		payload.Metadata["run_id"] = opts.RunID
 	}
 
-	c.debugLog("Storing memory with UserID: %s, AgentID: %s, RunID: %s",
-		opts.UserID, opts.AgentID, opts.RunID)
-
-	jsonPayload, err := json.Marshal(opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal payload: %v", err)
+	// Request an explicit output format so the response shape is known
+	format := c.config.version
+	if opts.OutputFormat != nil && *opts.OutputFormat != "" {
+		format = *opts.OutputFormat
 	}
+	if format != OutputFormatV10 && format != OutputFormatV11 {
+		return nil, fmt.Errorf("unsupported output format: %s", format)
+	}
+	payload.OutputFormat = &format
 
-	c.debugLog("Json Payload: %+v", string(jsonPayload))
-
-	req, err := http.NewRequestWithContext(ctx, "POST", c.config.BaseURL+"/memories/", bytes.NewBuffer(jsonPayload))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %v", err)
+	orgID, projectID := c.resolveScope(ctx, derefString(opts.OrganizationID), derefString(opts.ProjectID))
+	payload.OrganizationID, payload.ProjectID = nil, nil
+	if orgID != "" {
+		payload.OrganizationID = &orgID
+	}
+	if projectID != "" {
+		payload.ProjectID = &projectID
 	}
-	req.Header.Set("Content-Type", "application/json")
-	c.prepareRequest(req)
 
-	resp, err := c.config.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %v", err)
+	if opts.IdempotencyKey != "" {
+		ctx = context.WithValue(ctx, idempotencyKeyContextKey{}, opts.IdempotencyKey)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, c.parseErrorResponse(resp.Body)
+	var raw json.RawMessage
+	if _, err := c.doJSON(ctx, "POST", c.config.BaseURL+"/memories/", &payload, &raw); err != nil {
+		return nil, err
 	}
 
-	// Read the response body
-	body, err := io.ReadAll(resp.Body)
+	result, err := decodeAddResult(raw)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %v", err)
+		return nil, err
 	}
 
-	c.debugLog("Raw response body: %s", string(body))
-
-	var memory ResponseSingleMemory
-	if err := json.NewDecoder(resp.Body).Decode(&memory); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %v", err)
+	if result.EventID != "" {
+		c.log(ctx, slog.LevelDebug, "mem0 store queued", slog.String("event_id", result.EventID), slog.String("event_status", string(result.Status)))
 	}
-
-	c.debugLog("Stored memory ID: %s", memory.ID)
-	return &memory, nil
+	return result, nil
 }
 
 // GetMemoriesOptions represents optional filters for retrieving memories
@@ -338,11 +505,35 @@ type GetMemoriesOptions struct {
 	Keywords   string            `json:"keywords,omitempty"`
 	Page       int               `json:"page,omitempty"`
 	PageSize   int               `json:"page_size,omitempty"`
+	// EnableGraph also returns the graph relations of the matching entities
+	EnableGraph bool `json:"enable_graph,omitempty"`
 }
 
+// GetMemories retrieves a single page of memories matching the given filters
 func (c *Mem0Client) GetMemories(ctx context.Context, opts *GetMemoriesOptions) ([]ResponseGetMemories, error) {
-	c.debugLog("Getting memories with options: %+v", opts)
+	return runOperation(c, ctx, OpGetMemories, opts, c.getMemories)
+}
 
+func (c *Mem0Client) getMemories(ctx context.Context, opts *GetMemoriesOptions) ([]ResponseGetMemories, error) {
+	page, err := c.getMemoriesPage(ctx, opts, "")
+	if err != nil {
+		return nil, err
+	}
+	return page.Memories, nil
+}
+
+// memoriesPage is a single page of GetMemories results
+type memoriesPage struct {
+	Memories []ResponseGetMemories
+	// Count is the total reported by the v1.1 API, or -1 when the response does not carry one
+	Count     int
+	Relations []Relation
+}
+
+// getMemoriesPage retrieves a single page of memories along with the total count
+// and graph relations when the API reports them. A non-empty outputFormat is sent
+// as output_format; the v1.1 format is always requested with EnableGraph.
+func (c *Mem0Client) getMemoriesPage(ctx context.Context, opts *GetMemoriesOptions, outputFormat string) (*memoriesPage, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", c.config.BaseURL+"/memories/", nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %v", err)
@@ -391,19 +582,28 @@ func (c *Mem0Client) GetMemories(ctx context.Context, opts *GetMemoriesOptions)
 		if opts.PageSize > 0 {
 			q.Add("page_size", fmt.Sprintf("%d", opts.PageSize))
 		}
+		if opts.EnableGraph {
+			// Relations are only returned in the v1.1 output format
+			q.Add("enable_graph", "true")
+			outputFormat = OutputFormatV11
+		}
 	}
+	if outputFormat != "" {
+		q.Set("output_format", outputFormat)
+	}
+	c.scopeQuery(ctx, q)
 	req.URL.RawQuery = q.Encode()
 
 	c.prepareRequest(req)
 
-	resp, err := c.config.HTTPClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %v", err)
+		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, c.parseErrorResponse(resp.Body)
+		return nil, c.parseErrorResponse(resp)
 	}
 
 	// Read the response body
@@ -412,42 +612,84 @@ func (c *Mem0Client) GetMemories(ctx context.Context, opts *GetMemoriesOptions)
 		return nil, fmt.Errorf("failed to read response body: %v", err)
 	}
 
-	c.debugLog("Raw response body: %s", string(body))
+	if c.logEnabled(ctx, slog.LevelDebug) {
+		c.log(ctx, slog.LevelDebug, "mem0 response payload", slog.String("body", c.redactBody(slog.LevelDebug, body)))
+	}
 
 	// Try to decode as v1.1 API response with 'results' key
 	var v11Response struct {
-		Results []ResponseGetMemories `json:"results"`
-		Count   int                   `json:"count"`
+		Results   []ResponseGetMemories `json:"results"`
+		Count     *int                  `json:"count"`
+		Relations []Relation            `json:"relations"`
 	}
 	err = json.Unmarshal(body, &v11Response)
 	if err == nil {
-		c.debugLog("API Response Count: %d", v11Response.Count)
-		if v11Response.Count > 0 {
-			c.debugLog("Retrieved %d memories from v1.1 API", len(v11Response.Results))
-			return v11Response.Results, nil
+		count := -1
+		if v11Response.Count != nil {
+			count = *v11Response.Count
+		}
+		if count > 0 || len(v11Response.Results) > 0 {
+			return &memoriesPage{Memories: v11Response.Results, Count: count, Relations: v11Response.Relations}, nil
 		}
-		return []ResponseGetMemories{}, nil
+		return &memoriesPage{Memories: []ResponseGetMemories{}, Count: count, Relations: v11Response.Relations}, nil
 	}
 
 	// Try to decode as an array first
 	var memories []ResponseGetMemories
 	err = json.Unmarshal(body, &memories)
-	if err == nil && len(memories) > 0 {
-		c.debugLog("Retrieved %d memories", len(memories))
-		return memories, nil
+	if err == nil {
+		return &memoriesPage{Memories: memories, Count: -1}, nil
 	}
 
 	// If array decoding fails, try decoding as a single object
 	var singleMemory ResponseGetMemories
 	err = json.Unmarshal(body, &singleMemory)
 	if err == nil {
-		c.debugLog("Retrieved 1 memory")
-		return []ResponseGetMemories{singleMemory}, nil
+		return &memoriesPage{Memories: []ResponseGetMemories{singleMemory}, Count: -1}, nil
 	}
 
 	return nil, fmt.Errorf("failed to decode response: %v. Raw response: %s", err, string(body))
 }
 
+// GetMemory retrieves a single memory by its ID
+func (c *Mem0Client) GetMemory(ctx context.Context, memoryID string) (*ResponseSingleMemory, error) {
+	return runOperation(c, ctx, OpGetMemory, memoryID, c.getMemory)
+}
+
+func (c *Mem0Client) getMemory(ctx context.Context, memoryID string) (*ResponseSingleMemory, error) {
+	if memoryID == "" {
+		return nil, fmt.Errorf("memory id is required")
+	}
+
+	reqURL := c.scopedURL(ctx, fmt.Sprintf("%s/memories/%s/", c.config.BaseURL, url.PathEscape(memoryID)))
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	c.prepareRequest(req)
+
+	resp, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, &MemoryNotFoundError{MemoryID: memoryID, Err: c.parseErrorResponse(resp)}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, c.parseErrorResponse(resp)
+	}
+
+	var memory ResponseSingleMemory
+	if err := json.NewDecoder(resp.Body).Decode(&memory); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %v", err)
+	}
+
+	return &memory, nil
+}
+
 // SearchMemoriesOptions represents options for semantic memory search
 type SearchMemoriesOptions struct {
 	Query                   string            `json:"query"`
@@ -465,45 +707,77 @@ type SearchMemoriesOptions struct {
 	FilterMemories          bool              `json:"filter_memories,omitempty"`
 	Categories              []string          `json:"categories,omitempty"`
 	OnlyMetadataBasedSearch bool              `json:"only_metadata_based_search,omitempty"`
+	EnableGraph             bool              `json:"enable_graph,omitempty"`
 }
 
 // SearchMemories performs a semantic search on memories
 func (c *Mem0Client) SearchMemories(ctx context.Context, opts *SearchMemoriesOptions) ([]ResponseSearchMemories, error) {
-	c.debugLog("Searching memories with options: %+v", opts)
+	return runOperation(c, ctx, OpSearchMemories, opts, c.searchMemories)
+}
 
+func (c *Mem0Client) searchMemories(ctx context.Context, opts *SearchMemoriesOptions) ([]ResponseSearchMemories, error) {
 	if opts == nil || opts.Query == "" {
 		return nil, fmt.Errorf("query is required for searching memories")
 	}
 
-	payload, err := json.Marshal(opts)
+	result, err := c.search(ctx, opts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal search options: %v", err)
+		return nil, err
 	}
+	return result.Memories, nil
+}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", c.config.BaseURL+"/memories/search/", bytes.NewBuffer(payload))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %v", err)
-	}
-	req.Header.Set("Content-Type", "application/json")
-	c.prepareRequest(req)
+// SearchResult holds the memories and graph relations returned by a search
+type SearchResult struct {
+	Memories  []ResponseSearchMemories
+	Relations []Relation
+}
 
-	resp, err := c.config.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %v", err)
+// SearchMemoriesWithRelations performs a semantic search with graph memory enabled
+// and returns the matching memories together with their graph relations
+func (c *Mem0Client) SearchMemoriesWithRelations(ctx context.Context, opts *SearchMemoriesOptions) (*SearchResult, error) {
+	return runOperation(c, ctx, OpSearchMemoriesWithRelations, opts, c.searchMemoriesWithRelations)
+}
+
+func (c *Mem0Client) searchMemoriesWithRelations(ctx context.Context, opts *SearchMemoriesOptions) (*SearchResult, error) {
+	if opts == nil || opts.Query == "" {
+		return nil, fmt.Errorf("query is required for searching memories")
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, c.parseErrorResponse(resp.Body)
+	graphOpts := *opts
+	graphOpts.EnableGraph = true
+	// Relations are only returned in the v1.1 output format
+	graphOpts.OutputFormat = OutputFormatV11
+
+	return c.search(ctx, &graphOpts)
+}
+
+// search sends the search request and decodes either a bare list of memories or
+// a v1.1 style {"results": [...], "relations": [...]} object
+func (c *Mem0Client) search(ctx context.Context, opts *SearchMemoriesOptions) (*SearchResult, error) {
+	payload := *opts
+	payload.OrgID, payload.ProjectID = c.resolveScope(ctx, opts.OrgID, opts.ProjectID)
+
+	var raw json.RawMessage
+	if _, err := c.doJSON(markReadOnly(ctx), "POST", c.config.BaseURL+"/memories/search/", &payload, &raw); err != nil {
+		return nil, err
 	}
 
-	var memories []ResponseSearchMemories
-	if err := json.NewDecoder(resp.Body).Decode(&memories); err != nil {
-		return nil, fmt.Errorf("failed to decode response: %v", err)
+	result := &SearchResult{}
+	if err := json.Unmarshal(raw, &result.Memories); err != nil {
+		var v11Response struct {
+			Results   []ResponseSearchMemories `json:"results"`
+			Relations []Relation               `json:"relations"`
+		}
+		if err := json.Unmarshal(raw, &v11Response); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %v", err)
+		}
+		result.Memories = v11Response.Results
+		result.Relations = v11Response.Relations
 	}
 
-	c.debugLog("Found %d memories in search", len(memories))
-	return memories, nil
+	c.log(ctx, slog.LevelDebug, "mem0 search results", slog.Int("count", len(result.Memories)), slog.Int("relations", len(result.Relations)))
+	return result, nil
 }
 
 // UpdateMemoryOptions represents the options for updating a memory
@@ -517,7 +791,14 @@ type UpdateMemoryOptions struct {
 
 // UpdateMemory updates a specific memory by its ID
 func (c *Mem0Client) UpdateMemory(ctx context.Context, memoryID string, opts *UpdateMemoryOptions) (*Memory, error) {
-	c.debugLog("Updating memory %s with options: %+v", memoryID, opts)
+	return runOperation(c, ctx, OpUpdateMemory, &UpdateMemoryRequest{MemoryID: memoryID, Options: opts}, c.updateMemory)
+}
+
+func (c *Mem0Client) updateMemory(ctx context.Context, r *UpdateMemoryRequest) (*Memory, error) {
+	if r == nil {
+		return nil, fmt.Errorf("update memory request cannot be nil")
+	}
+	memoryID, opts := r.MemoryID, r.Options
 
 	if opts == nil || opts.Text == "" {
 		return nil, fmt.Errorf("text is required for updating a memory")
@@ -528,21 +809,22 @@ func (c *Mem0Client) UpdateMemory(ctx context.Context, memoryID string, opts *Up
 		return nil, fmt.Errorf("failed to marshal update options: %v", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/memories/%s/", c.config.BaseURL, memoryID), bytes.NewBuffer(payload))
+	reqURL := c.scopedURL(ctx, fmt.Sprintf("%s/memories/%s/", c.config.BaseURL, url.PathEscape(memoryID)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", reqURL, bytes.NewBuffer(payload))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %v", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 	c.prepareRequest(req)
 
-	resp, err := c.config.HTTPClient.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %v", err)
+		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, c.parseErrorResponse(resp.Body)
+		return nil, c.parseErrorResponse(resp)
 	}
 
 	var updatedMemory Memory
@@ -550,6 +832,95 @@ func (c *Mem0Client) UpdateMemory(ctx context.Context, memoryID string, opts *Up
 		return nil, fmt.Errorf("failed to decode response: %v", err)
 	}
 
-	c.debugLog("Updated memory ID: %s", updatedMemory.ID)
 	return &updatedMemory, nil
 }
+
+// DeleteMemory deletes a specific memory by its ID
+func (c *Mem0Client) DeleteMemory(ctx context.Context, memoryID string) error {
+	return runErrOperation(c, ctx, OpDeleteMemory, memoryID, c.deleteMemory)
+}
+
+func (c *Mem0Client) deleteMemory(ctx context.Context, memoryID string) error {
+	if memoryID == "" {
+		return fmt.Errorf("memory id is required")
+	}
+
+	reqURL := c.scopedURL(ctx, fmt.Sprintf("%s/memories/%s/", c.config.BaseURL, url.PathEscape(memoryID)))
+	status, err := c.doJSON(ctx, "DELETE", reqURL, nil, nil)
+	if status == http.StatusNotFound {
+		return &MemoryNotFoundError{MemoryID: memoryID, Err: err}
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAllOptions scopes which memories DeleteAll removes
+type DeleteAllOptions struct {
+	UserID    string
+	AgentID   string
+	AppID     string
+	RunID     string
+	OrgID     string
+	ProjectID string
+	// Reset must be set to delete every memory when no user, agent, app or run scope is given
+	Reset bool
+}
+
+// DeleteAll deletes all memories matching the given scope. Without a user, agent,
+// app or run ID it refuses to run unless opts.Reset is set.
+func (c *Mem0Client) DeleteAll(ctx context.Context, opts *DeleteAllOptions) error {
+	return runErrOperation(c, ctx, OpDeleteAll, opts, c.deleteAll)
+}
+
+func (c *Mem0Client) deleteAll(ctx context.Context, opts *DeleteAllOptions) error {
+	if opts == nil {
+		opts = &DeleteAllOptions{}
+	}
+
+	scoped := opts.UserID != "" || opts.AgentID != "" || opts.AppID != "" || opts.RunID != ""
+	if !scoped && !opts.Reset {
+		return fmt.Errorf("one of the following is required: user_id, agent_id, app_id or run_id; set Reset to delete all memories")
+	}
+
+	q := url.Values{}
+	if opts.UserID != "" {
+		q.Add("user_id", opts.UserID)
+	}
+	if opts.AgentID != "" {
+		q.Add("agent_id", opts.AgentID)
+	}
+	if opts.AppID != "" {
+		q.Add("app_id", opts.AppID)
+	}
+	if opts.RunID != "" {
+		q.Add("run_id", opts.RunID)
+	}
+	if opts.OrgID != "" {
+		q.Add("org_id", opts.OrgID)
+	}
+	if opts.ProjectID != "" {
+		q.Add("project_id", opts.ProjectID)
+	}
+	c.scopeQuery(ctx, q)
+
+	endpoint := "/memories/"
+	if len(q) > 0 {
+		endpoint += "?" + q.Encode()
+	}
+
+	if _, err := c.doJSON(ctx, "DELETE", c.config.BaseURL+endpoint, nil, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reset deletes every memory the API key has access to
+func (c *Mem0Client) Reset(ctx context.Context) error {
+	return runErrOperation(c, ctx, OpReset, nil, func(ctx context.Context, _ interface{}) error {
+		return c.deleteAll(ctx, &DeleteAllOptions{Reset: true})
+	})
+}
@@ -0,0 +1,124 @@
+package mem0client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// GetMemoriesV2Options represents the options for retrieving memories with a v2 filter expression
+type GetMemoriesV2Options struct {
+	Filters   Filter   `json:"filters"`
+	Fields    []string `json:"fields,omitempty"`
+	OrgID     string   `json:"org_id,omitempty"`
+	ProjectID string   `json:"project_id,omitempty"`
+	Page      int      `json:"-"`
+	PageSize  int      `json:"-"`
+}
+
+// GetMemoriesV2 retrieves memories matching a v2 filter expression
+func (c *Mem0Client) GetMemoriesV2(ctx context.Context, opts *GetMemoriesV2Options) ([]ResponseGetMemories, error) {
+	return runOperation(c, ctx, OpGetMemoriesV2, opts, c.getMemoriesV2)
+}
+
+func (c *Mem0Client) getMemoriesV2(ctx context.Context, opts *GetMemoriesV2Options) ([]ResponseGetMemories, error) {
+	if opts == nil {
+		return nil, fmt.Errorf("filters are required for retrieving memories")
+	}
+	if err := opts.Filters.Validate(); err != nil {
+		return nil, err
+	}
+
+	payload := *opts
+	payload.Filters = rootFilter(opts.Filters)
+	payload.OrgID, payload.ProjectID = c.resolveScope(ctx, opts.OrgID, opts.ProjectID)
+
+	q := url.Values{}
+	if opts.Page > 0 {
+		q.Add("page", fmt.Sprintf("%d", opts.Page))
+	}
+	if opts.PageSize > 0 {
+		q.Add("page_size", fmt.Sprintf("%d", opts.PageSize))
+	}
+
+	reqURL := c.baseURLFor("v2") + "/memories/"
+	if len(q) > 0 {
+		reqURL += "?" + q.Encode()
+	}
+
+	var raw json.RawMessage
+	if _, err := c.doJSON(markReadOnly(ctx), "POST", reqURL, &payload, &raw); err != nil {
+		return nil, err
+	}
+
+	var memories []ResponseGetMemories
+	if err := decodeResultList(raw, &memories); err != nil {
+		return nil, err
+	}
+
+	return memories, nil
+}
+
+// SearchMemoriesV2Options represents options for semantic memory search with a v2 filter expression
+type SearchMemoriesV2Options struct {
+	Query     string   `json:"query"`
+	Filters   Filter   `json:"filters"`
+	TopK      int      `json:"top_k,omitempty"`
+	Fields    []string `json:"fields,omitempty"`
+	Rerank    bool     `json:"rerank,omitempty"`
+	Threshold float64  `json:"threshold,omitempty"`
+	OrgID     string   `json:"org_id,omitempty"`
+	ProjectID string   `json:"project_id,omitempty"`
+}
+
+// SearchMemoriesV2 performs a semantic search on memories matching a v2 filter expression
+func (c *Mem0Client) SearchMemoriesV2(ctx context.Context, opts *SearchMemoriesV2Options) ([]ResponseSearchMemories, error) {
+	return runOperation(c, ctx, OpSearchMemoriesV2, opts, c.searchMemoriesV2)
+}
+
+func (c *Mem0Client) searchMemoriesV2(ctx context.Context, opts *SearchMemoriesV2Options) ([]ResponseSearchMemories, error) {
+	if opts == nil || opts.Query == "" {
+		return nil, fmt.Errorf("query is required for searching memories")
+	}
+	if err := opts.Filters.Validate(); err != nil {
+		return nil, err
+	}
+
+	payload := *opts
+	payload.Filters = rootFilter(opts.Filters)
+	payload.OrgID, payload.ProjectID = c.resolveScope(ctx, opts.OrgID, opts.ProjectID)
+
+	var raw json.RawMessage
+	if _, err := c.doJSON(markReadOnly(ctx), "POST", c.baseURLFor("v2")+"/memories/search/", &payload, &raw); err != nil {
+		return nil, err
+	}
+
+	var memories []ResponseSearchMemories
+	if err := decodeResultList(raw, &memories); err != nil {
+		return nil, err
+	}
+
+	return memories, nil
+}
+
+// decodeResultList decodes either a bare JSON list or a v1.1 style {"results": [...]} object into out
+func decodeResultList(raw json.RawMessage, out interface{}) error {
+	if err := json.Unmarshal(raw, out); err == nil {
+		return nil
+	}
+
+	var v11Response struct {
+		Results json.RawMessage `json:"results"`
+	}
+	if err := json.Unmarshal(raw, &v11Response); err != nil {
+		return fmt.Errorf("failed to decode response: %v", err)
+	}
+	if len(v11Response.Results) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(v11Response.Results, out); err != nil {
+		return fmt.Errorf("failed to decode response: %v", err)
+	}
+	return nil
+}
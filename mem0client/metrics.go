@@ -0,0 +1,316 @@
+package mem0client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the latency histogram buckets
+var DefaultLatencyBuckets = []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// otherOperation labels requests made outside a client operation
+const otherOperation = "other"
+
+// MetricsOptions configures a Metrics collector
+type MetricsOptions struct {
+	// LatencyBuckets are the histogram bucket upper bounds in seconds (default DefaultLatencyBuckets)
+	LatencyBuckets []float64
+}
+
+// Metrics collects per-operation latency, payload size, retry and error metrics.
+// It is safe for concurrent use and can be shared by several clients; Handler
+// exposes it in the Prometheus text format.
+type Metrics struct {
+	buckets []float64
+
+	mu            sync.Mutex
+	latency       map[operationLabels]*histogram
+	operations    map[outcomeLabels]uint64
+	errors        map[errorLabels]uint64
+	requestBytes  map[string]uint64
+	responseBytes map[string]uint64
+	retries       map[string]uint64
+}
+
+type operationLabels struct {
+	op      string
+	project string
+}
+
+type outcomeLabels struct {
+	operationLabels
+	outcome string
+}
+
+type errorLabels struct {
+	operationLabels
+	status string
+	code   string
+}
+
+type histogram struct {
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// NewMetrics creates a Metrics collector
+func NewMetrics(opts MetricsOptions) *Metrics {
+	buckets := opts.LatencyBuckets
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+
+	return &Metrics{
+		buckets:       buckets,
+		latency:       map[operationLabels]*histogram{},
+		operations:    map[outcomeLabels]uint64{},
+		errors:        map[errorLabels]uint64{},
+		requestBytes:  map[string]uint64{},
+		responseBytes: map[string]uint64{},
+		retries:       map[string]uint64{},
+	}
+}
+
+// WithMetrics records the metrics of every request in metrics
+func WithMetrics(metrics *Metrics) func(*Mem0ClientConfig) {
+	return func(c *Mem0ClientConfig) {
+		c.Metrics = metrics
+	}
+}
+
+// operationName returns the metrics label of the operation a request belongs to
+func operationName(ctx context.Context) string {
+	if state := operationOf(ctx); state != nil {
+		return state.name
+	}
+	return otherOperation
+}
+
+// optionsProject returns the project ID set on the options of an operation, if any
+func optionsProject(opts interface{}) string {
+	switch o := opts.(type) {
+	case *StoreOptions:
+		if o != nil {
+			return derefString(o.ProjectID)
+		}
+	case *GetMemoriesOptions:
+		if o != nil {
+			return o.ProjectID
+		}
+	case *SearchMemoriesOptions:
+		if o != nil {
+			return o.ProjectID
+		}
+	case *GetMemoriesV2Options:
+		if o != nil {
+			return o.ProjectID
+		}
+	case *SearchMemoriesV2Options:
+		if o != nil {
+			return o.ProjectID
+		}
+	case *DeleteAllOptions:
+		if o != nil {
+			return o.ProjectID
+		}
+	case *GetRelationsOptions:
+		if o != nil {
+			return o.ProjectID
+		}
+	case *EntityFilter:
+		if o != nil {
+			return o.ProjectID
+		}
+	case *DeleteProjectRequest:
+		if o != nil {
+			return o.ProjectID
+		}
+	case *ListMembersRequest:
+		if o != nil {
+			return o.ProjectID
+		}
+	case *ChangeMemberRequest:
+		if o != nil {
+			return o.ProjectID
+		}
+	case *CreateWebhookRequest:
+		if o != nil {
+			return o.ProjectID
+		}
+	}
+	return ""
+}
+
+// observeOperation records the latency and outcome of an operation
+func (m *Metrics) observeOperation(op, project string, seconds float64, status int, err error) {
+	labels := operationLabels{op: op, project: project}
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	h := m.latency[labels]
+	if h == nil {
+		h = &histogram{counts: make([]uint64, len(m.buckets))}
+		m.latency[labels] = h
+	}
+	for i, bound := range m.buckets {
+		if seconds <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += seconds
+	h.count++
+
+	m.operations[outcomeLabels{operationLabels: labels, outcome: outcome}]++
+
+	if err != nil {
+		var code string
+		var apiErr *Mem0Error
+		if errors.As(err, &apiErr) {
+			code = apiErr.Code
+		}
+		statusLabel := "none"
+		if status != 0 {
+			statusLabel = strconv.Itoa(status)
+		}
+		m.errors[errorLabels{operationLabels: labels, status: statusLabel, code: code}]++
+	}
+}
+
+func (m *Metrics) addRequestBytes(op string, n int64) {
+	if n <= 0 {
+		return
+	}
+	m.mu.Lock()
+	m.requestBytes[op] += uint64(n)
+	m.mu.Unlock()
+}
+
+func (m *Metrics) addResponseBytes(op string, n int64) {
+	if n <= 0 {
+		return
+	}
+	m.mu.Lock()
+	m.responseBytes[op] += uint64(n)
+	m.mu.Unlock()
+}
+
+func (m *Metrics) addRetry(op string) {
+	m.mu.Lock()
+	m.retries[op]++
+	m.mu.Unlock()
+}
+
+// countingBody reports the bytes read from a response body
+type countingBody struct {
+	io.ReadCloser
+	onRead func(n int64)
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.onRead(int64(n))
+	return n, err
+}
+
+// Handler returns an http.Handler serving the metrics in the Prometheus text exposition format
+func (m *Metrics) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		m.WriteText(w)
+	})
+}
+
+// WriteText writes the metrics in the Prometheus text exposition format
+func (m *Metrics) WriteText(w io.Writer) error {
+	var b strings.Builder
+
+	m.mu.Lock()
+	writeHeader(&b, "mem0_operation_duration_seconds", "histogram", "Latency of client operations.")
+	for _, labels := range sortedKeys(m.latency, func(l operationLabels) string { return l.op + "\x00" + l.project }) {
+		h := m.latency[labels]
+		base := formatLabels("op", labels.op, "project", labels.project)
+		for i, bound := range m.buckets {
+			fmt.Fprintf(&b, "mem0_operation_duration_seconds_bucket%s %d\n",
+				formatLabels("op", labels.op, "project", labels.project, "le", strconv.FormatFloat(bound, 'g', -1, 64)), h.counts[i])
+		}
+		fmt.Fprintf(&b, "mem0_operation_duration_seconds_bucket%s %d\n",
+			formatLabels("op", labels.op, "project", labels.project, "le", "+Inf"), h.count)
+		fmt.Fprintf(&b, "mem0_operation_duration_seconds_sum%s %s\n", base, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(&b, "mem0_operation_duration_seconds_count%s %d\n", base, h.count)
+	}
+
+	writeHeader(&b, "mem0_operations_total", "counter", "Client operations by outcome.")
+	for _, labels := range sortedKeys(m.operations, func(l outcomeLabels) string { return l.op + "\x00" + l.project + "\x00" + l.outcome }) {
+		fmt.Fprintf(&b, "mem0_operations_total%s %d\n",
+			formatLabels("op", labels.op, "project", labels.project, "outcome", labels.outcome), m.operations[labels])
+	}
+
+	writeHeader(&b, "mem0_errors_total", "counter", "Failed client operations by HTTP status and Mem0 error code.")
+	for _, labels := range sortedKeys(m.errors, func(l errorLabels) string {
+		return l.op + "\x00" + l.project + "\x00" + l.status + "\x00" + l.code
+	}) {
+		fmt.Fprintf(&b, "mem0_errors_total%s %d\n",
+			formatLabels("op", labels.op, "project", labels.project, "status", labels.status, "code", labels.code), m.errors[labels])
+	}
+
+	writeCounter(&b, "mem0_request_bytes_total", "Bytes sent in request bodies.", m.requestBytes)
+	writeCounter(&b, "mem0_response_bytes_total", "Bytes received in response bodies.", m.responseBytes)
+	writeCounter(&b, "mem0_retries_total", "Retried requests.", m.retries)
+	m.mu.Unlock()
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeHeader(b *strings.Builder, name, kind, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+func writeCounter(b *strings.Builder, name, help string, values map[string]uint64) {
+	writeHeader(b, name, "counter", help)
+	for _, op := range sortedKeys(values, func(op string) string { return op }) {
+		fmt.Fprintf(b, "%s%s %d\n", name, formatLabels("op", op), values[op])
+	}
+}
+
+// sortedKeys returns the keys of m ordered by key, so the output is stable
+func sortedKeys[K comparable, V any](m map[K]V, key func(K) string) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return key(keys[i]) < key(keys[j]) })
+	return keys
+}
+
+// labelEscaper escapes label values as required by the text exposition format
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// formatLabels formats name/value pairs as {name="value",...}
+func formatLabels(pairs ...string) string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		fmt.Fprintf(&b, `%s="%s"`, pairs[i], labelEscaper.Replace(pairs[i+1]))
+	}
+	b.WriteByte('}')
+	return b.String()
+}
@@ -0,0 +1,84 @@
+package mem0client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func metricsText(t *testing.T, m *Metrics) string {
+	t.Helper()
+	var b strings.Builder
+	if err := m.WriteText(&b); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
+
+func TestMetricsHistogram(t *testing.T) {
+	m := NewMetrics(MetricsOptions{LatencyBuckets: []float64{1, 0.1}})
+	project := "a\"b\\c\nd"
+	for _, seconds := range []float64{0.0625, 0.5, 2} {
+		m.observeOperation(OpSearchMemories, project, seconds, 200, nil)
+	}
+
+	text := metricsText(t, m)
+	labels := `op="SearchMemories",project="a\"b\\c\nd"`
+	for _, want := range []string{
+		"# TYPE mem0_operation_duration_seconds histogram\n",
+		`mem0_operation_duration_seconds_bucket{` + labels + `,le="0.1"} 1` + "\n",
+		`mem0_operation_duration_seconds_bucket{` + labels + `,le="1"} 2` + "\n",
+		`mem0_operation_duration_seconds_bucket{` + labels + `,le="+Inf"} 3` + "\n",
+		`mem0_operation_duration_seconds_sum{` + labels + `} 2.5625` + "\n",
+		`mem0_operation_duration_seconds_count{` + labels + `} 3` + "\n",
+		`mem0_operations_total{` + labels + `,outcome="success"} 3` + "\n",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("metrics do not contain %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "mem0_errors_total{") {
+		t.Errorf("successful operations recorded as errors:\n%s", text)
+	}
+}
+
+func TestMetricsErrorLabels(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "mem-1") {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"detail": "Invalid filter", "code": "invalid_filter"}`))
+	}))
+	defer srv.Close()
+	m := NewMetrics(MetricsOptions{})
+	c := NewMem0Client("test-key", WithBaseURL(srv.URL), WithMetrics(m), WithProjectID("prj-1"), WithRetryPolicy(fastRetries))
+
+	if _, err := c.GetMemories(context.Background(), &GetMemoriesOptions{UserID: "alex"}); !errors.Is(err, ErrValidation) {
+		t.Fatalf("GetMemories() = %v, want a validation error", err)
+	}
+	if _, err := c.GetMemory(context.Background(), "mem-1"); !errors.Is(err, ErrServer) {
+		t.Fatalf("GetMemory() = %v, want a server error", err)
+	}
+
+	text := metricsText(t, m)
+	for _, want := range []string{
+		`mem0_errors_total{op="GetMemories",project="prj-1",status="400",code="invalid_filter"} 1` + "\n",
+		`mem0_errors_total{op="GetMemory",project="prj-1",status="503",code=""} 1` + "\n",
+		`mem0_operations_total{op="GetMemories",project="prj-1",outcome="error"} 1` + "\n",
+		`mem0_operations_total{op="GetMemory",project="prj-1",outcome="error"} 1` + "\n",
+		`mem0_operation_duration_seconds_count{op="GetMemory",project="prj-1"} 1` + "\n",
+		`mem0_retries_total{op="GetMemory"} 2` + "\n",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("metrics do not contain %q:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, `mem0_retries_total{op="GetMemories"}`) {
+		t.Errorf("the 400 response was retried:\n%s", text)
+	}
+}
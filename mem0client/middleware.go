@@ -0,0 +1,188 @@
+package mem0client
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+)
+
+// Operation names passed to middlewares, one per public client method
+const (
+	OpStore                       = "Store"
+	OpGetMemories                 = "GetMemories"
+	OpGetMemory                   = "GetMemory"
+	OpSearchMemories              = "SearchMemories"
+	OpSearchMemoriesWithRelations = "SearchMemoriesWithRelations"
+	OpUpdateMemory                = "UpdateMemory"
+	OpDeleteMemory                = "DeleteMemory"
+	OpDeleteAll                   = "DeleteAll"
+	OpReset                       = "Reset"
+	OpHistory                     = "History"
+	OpBatchUpdate                 = "BatchUpdate"
+	OpBatchDelete                 = "BatchDelete"
+	OpGetMemoriesV2               = "GetMemoriesV2"
+	OpSearchMemoriesV2            = "SearchMemoriesV2"
+	OpGetRelations                = "GetRelations"
+	OpListEntities                = "ListEntities"
+	OpDeleteEntity                = "DeleteEntity"
+	OpSubmitFeedback              = "SubmitFeedback"
+	OpListFeedback                = "ListFeedback"
+	OpGetEvent                    = "GetEvent"
+	OpWaitForEvent                = "WaitForEvent"
+	OpCreateExport                = "CreateExport"
+	OpGetExport                   = "GetExport"
+	OpWaitForExport               = "WaitForExport"
+	OpWriteExport                 = "WriteExport"
+	OpGetProject                  = "GetProject"
+	OpUpdateProject               = "UpdateProject"
+	OpListOrganizations           = "ListOrganizations"
+	OpListProjects                = "ListProjects"
+	OpCreateProject               = "CreateProject"
+	OpDeleteProject               = "DeleteProject"
+	OpListOrganizationMembers     = "ListOrganizationMembers"
+	OpAddOrganizationMember       = "AddOrganizationMember"
+	OpUpdateOrganizationMember    = "UpdateOrganizationMember"
+	OpRemoveOrganizationMember    = "RemoveOrganizationMember"
+	OpListProjectMembers          = "ListProjectMembers"
+	OpAddProjectMember            = "AddProjectMember"
+	OpUpdateProjectMember         = "UpdateProjectMember"
+	OpRemoveProjectMember         = "RemoveProjectMember"
+	OpCreateWebhook               = "CreateWebhook"
+	OpListWebhooks                = "ListWebhooks"
+	OpUpdateWebhook               = "UpdateWebhook"
+	OpDeleteWebhook               = "DeleteWebhook"
+)
+
+// Operation describes a client call as seen by middlewares
+type Operation struct {
+	// Name is the client method being called, e.g. OpStore
+	Name string
+	// Options holds the typed arguments of the call: the single argument of methods
+	// that take one, e.g. *StoreOptions for Store or the memory ID string for
+	// GetMemory; a *...Request struct for methods that take several, e.g.
+	// *UpdateMemoryRequest for UpdateMemory; and nil for Reset and GetProject.
+	// A middleware may replace it with a value of the same type.
+	Options interface{}
+}
+
+// UpdateMemoryRequest holds the arguments of UpdateMemory
+type UpdateMemoryRequest struct {
+	MemoryID string
+	Options  *UpdateMemoryOptions
+}
+
+// Handler runs an operation and returns its typed result, e.g. *AddResult for
+// Store or []ResponseSearchMemories for SearchMemories. Operations that only
+// return an error produce a nil result.
+type Handler func(ctx context.Context, op *Operation) (interface{}, error)
+
+// Middleware wraps a Handler. It can inspect or change the operation before calling
+// next, inspect or change the result and error afterwards, or return without
+// calling next to short-circuit the call.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware adds middlewares around every client call. Middlewares run in
+// the order they are added: the first one sees the call first and the result last.
+// The option can be given several times; later middlewares are nested inside earlier ones.
+func WithMiddleware(middlewares ...Middleware) func(*Mem0ClientConfig) {
+	return func(c *Mem0ClientConfig) {
+		c.Middlewares = append(c.Middlewares, middlewares...)
+	}
+}
+
+// runOperation calls fn with opts through the configured middlewares, traces it and
+// records its outcome in the logs and metrics
+func runOperation[O, R any](c *Mem0Client, ctx context.Context, name string, opts O, fn func(context.Context, O) (R, error)) (R, error) {
+	start := time.Now()
+
+	ctx, state := withOperation(ctx, name)
+
+	var span Span
+	if c.config.Tracer != nil {
+		ctx, span = c.config.Tracer.Start(ctx, name)
+		span.SetAttributes(operationAttributes(name, opts)...)
+	}
+
+	result, err := chainOperation(c, ctx, name, opts, fn)
+	if span != nil {
+		endSpan(span, result, err)
+	}
+	if c.config.Metrics != nil {
+		_, project := c.resolveScope(ctx, "", optionsProject(opts))
+		c.config.Metrics.observeOperation(name, project, time.Since(start).Seconds(), int(state.status.Load()), err)
+	}
+
+	if c.logger != nil {
+		attrs := []any{slog.String("op", name), slog.Duration("duration", time.Since(start))}
+		if err != nil {
+			c.log(ctx, slog.LevelWarn, "mem0 operation failed", append(attrs, c.errorAttr(err))...)
+		} else {
+			if n, ok := resultCount(result); ok {
+				attrs = append(attrs, slog.Int("count", n))
+			}
+			c.log(ctx, slog.LevelDebug, "mem0 operation completed", attrs...)
+		}
+	}
+	return result, err
+}
+
+func chainOperation[O, R any](c *Mem0Client, ctx context.Context, name string, opts O, fn func(context.Context, O) (R, error)) (R, error) {
+	if len(c.config.Middlewares) == 0 {
+		return fn(ctx, opts)
+	}
+
+	var handler Handler = func(ctx context.Context, op *Operation) (interface{}, error) {
+		var o O
+		if op.Options != nil {
+			var ok bool
+			if o, ok = op.Options.(O); !ok {
+				return nil, fmt.Errorf("middleware replaced %s options with %T, expected %T", name, op.Options, o)
+			}
+		}
+		return fn(ctx, o)
+	}
+	for i := len(c.config.Middlewares) - 1; i >= 0; i-- {
+		handler = c.config.Middlewares[i](handler)
+	}
+
+	var result R
+	res, err := handler(ctx, &Operation{Name: name, Options: opts})
+	if res != nil {
+		var ok bool
+		if result, ok = res.(R); !ok {
+			return result, fmt.Errorf("middleware returned %T as %s result, expected %T", res, name, result)
+		}
+	}
+	return result, err
+}
+
+type operationContextKey struct{}
+
+// operationState follows a client operation through its HTTP requests
+type operationState struct {
+	name string
+	// status is the HTTP status of the last response, 0 before any
+	status atomic.Int32
+}
+
+// withOperation returns a context that attributes requests made with it to the named operation
+func withOperation(ctx context.Context, name string) (context.Context, *operationState) {
+	state := &operationState{name: name}
+	return context.WithValue(ctx, operationContextKey{}, state), state
+}
+
+// operationOf returns the operation state of ctx, if any
+func operationOf(ctx context.Context) *operationState {
+	state, _ := ctx.Value(operationContextKey{}).(*operationState)
+	return state
+}
+
+// runErrOperation is runOperation for operations that only return an error
+func runErrOperation[O any](c *Mem0Client, ctx context.Context, name string, opts O, fn func(context.Context, O) error) error {
+	_, err := runOperation(c, ctx, name, opts, func(ctx context.Context, o O) (interface{}, error) {
+		return nil, fn(ctx, o)
+	})
+	return err
+}
@@ -0,0 +1,88 @@
+package mem0client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestEveryCallRunsAsOperation(t *testing.T) {
+	var seen []string
+	record := func(next Handler) Handler {
+		return func(ctx context.Context, op *Operation) (interface{}, error) {
+			seen = append(seen, fmt.Sprintf("%s %T", op.Name, op.Options))
+			return nil, nil
+		}
+	}
+	c := NewMem0Client("test-key", WithMiddleware(record), WithOrganizationID("org-1"), WithProjectID("prj-1"))
+	ctx := context.Background()
+
+	calls := []struct {
+		want string
+		call func() error
+	}{
+		{"ListEntities *mem0client.EntityFilter", func() error { _, err := c.ListEntities(ctx, &EntityFilter{}); return err }},
+		{"DeleteEntity *mem0client.DeleteEntityRequest", func() error { return c.DeleteEntity(ctx, EntityTypeUser, "alex") }},
+		{"SubmitFeedback *mem0client.SubmitFeedbackRequest", func() error { _, err := c.SubmitFeedback(ctx, "mem-1", FeedbackPositive, ""); return err }},
+		{"ListFeedback string", func() error { _, err := c.ListFeedback(ctx, "mem-1"); return err }},
+		{"GetEvent string", func() error { _, err := c.GetEvent(ctx, "evt-1"); return err }},
+		{"WaitForEvent *mem0client.WaitRequest", func() error { _, err := c.WaitForEvent(ctx, "evt-1", 0); return err }},
+		{"CreateExport *mem0client.CreateExportRequest", func() error { _, err := c.CreateExport(ctx, map[string]interface{}{}, Filter{}); return err }},
+		{"GetExport string", func() error { _, err := c.GetExport(ctx, "exp-1"); return err }},
+		{"WaitForExport *mem0client.WaitRequest", func() error { _, err := c.WaitForExport(ctx, "exp-1", 0); return err }},
+		{"WriteExport *mem0client.WriteExportRequest", func() error { return c.WriteExport(ctx, &Export{}, io.Discard) }},
+		{"GetProject <nil>", func() error { _, err := c.GetProject(ctx); return err }},
+		{"UpdateProject *mem0client.UpdateProjectOptions", func() error { _, err := c.UpdateProject(ctx, &UpdateProjectOptions{}); return err }},
+		{"ListOrganizations *mem0client.ListOptions", func() error { _, err := c.ListOrganizations(ctx, nil); return err }},
+		{"ListProjects *mem0client.ListProjectsRequest", func() error { _, err := c.ListProjects(ctx, "", nil); return err }},
+		{"CreateProject *mem0client.CreateProjectRequest", func() error { _, err := c.CreateProject(ctx, "", nil); return err }},
+		{"DeleteProject *mem0client.DeleteProjectRequest", func() error { return c.DeleteProject(ctx, "", "prj-1") }},
+		{"ListOrganizationMembers *mem0client.ListMembersRequest", func() error { _, err := c.ListOrganizationMembers(ctx, "", nil); return err }},
+		{"AddOrganizationMember *mem0client.ChangeMemberRequest", func() error { return c.AddOrganizationMember(ctx, "", "a@example.com", MemberRoleReader) }},
+		{"UpdateOrganizationMember *mem0client.ChangeMemberRequest", func() error { return c.UpdateOrganizationMember(ctx, "", "a@example.com", MemberRoleOwner) }},
+		{"RemoveOrganizationMember *mem0client.ChangeMemberRequest", func() error { return c.RemoveOrganizationMember(ctx, "", "a@example.com") }},
+		{"ListProjectMembers *mem0client.ListMembersRequest", func() error { _, err := c.ListProjectMembers(ctx, "", "", nil); return err }},
+		{"AddProjectMember *mem0client.ChangeMemberRequest", func() error { return c.AddProjectMember(ctx, "", "", "a@example.com", MemberRoleReader) }},
+		{"UpdateProjectMember *mem0client.ChangeMemberRequest", func() error { return c.UpdateProjectMember(ctx, "", "", "a@example.com", MemberRoleOwner) }},
+		{"RemoveProjectMember *mem0client.ChangeMemberRequest", func() error { return c.RemoveProjectMember(ctx, "", "", "a@example.com") }},
+		{"CreateWebhook *mem0client.CreateWebhookRequest", func() error { _, err := c.CreateWebhook(ctx, "", nil); return err }},
+		{"ListWebhooks string", func() error { _, err := c.ListWebhooks(ctx, ""); return err }},
+		{"UpdateWebhook *mem0client.UpdateWebhookRequest", func() error { _, err := c.UpdateWebhook(ctx, "wh-1", nil); return err }},
+		{"DeleteWebhook string", func() error { return c.DeleteWebhook(ctx, "wh-1") }},
+	}
+	for _, tt := range calls {
+		seen = nil
+		if err := tt.call(); err != nil {
+			t.Errorf("%s: %v", tt.want, err)
+		}
+		if len(seen) != 1 || seen[0] != tt.want {
+			t.Errorf("middleware saw %q, want [%s]", seen, tt.want)
+		}
+	}
+}
+
+func TestOperationLabelsAdminRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+	metrics := NewMetrics(MetricsOptions{})
+	c := NewMem0Client("test-key", WithBaseURL(srv.URL), WithMetrics(metrics))
+
+	if err := c.DeleteWebhook(context.Background(), "wh-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	metrics.WriteText(&b)
+	if !strings.Contains(b.String(), `mem0_operations_total{op="DeleteWebhook",project="",outcome="success"} 1`) {
+		t.Errorf("metrics do not record DeleteWebhook:\n%s", b.String())
+	}
+	if strings.Contains(b.String(), `op="other"`) {
+		t.Errorf("admin request labelled as other:\n%s", b.String())
+	}
+}
@@ -0,0 +1,368 @@
+package mem0client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+)
+
+// MemberRole is the role of a member within an organization or project
+type MemberRole string
+
+const (
+	MemberRoleOwner  MemberRole = "OWNER"
+	MemberRoleReader MemberRole = "READER"
+)
+
+// Organization represents a Mem0 organization
+type Organization struct {
+	ID          string    `json:"id"`
+	OrgID       string    `json:"org_id,omitempty"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Member is a user with a role in an organization or project
+type Member struct {
+	UserID   string     `json:"user_id,omitempty"`
+	Username string     `json:"username,omitempty"`
+	Email    string     `json:"email"`
+	Role     MemberRole `json:"role"`
+}
+
+// ListOptions controls pagination of the administration list endpoints
+type ListOptions struct {
+	Page     int
+	PageSize int
+}
+
+// Page is a single page of results from a paginated list endpoint
+type Page[T any] struct {
+	Results []T `json:"results"`
+	// Count is the total number of results across all pages
+	Count    int    `json:"count"`
+	Next     string `json:"next,omitempty"`
+	Previous string `json:"previous,omitempty"`
+}
+
+// HasMore reports whether another page follows this one
+func (p *Page[T]) HasMore() bool {
+	return p.Next != ""
+}
+
+// CreateProjectOptions represents the options for creating a project
+type CreateProjectOptions struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type memberRequest struct {
+	Email string     `json:"email"`
+	Role  MemberRole `json:"role,omitempty"`
+}
+
+// ListProjectsRequest holds the arguments of ListProjects
+type ListProjectsRequest struct {
+	OrgID   string
+	Options *ListOptions
+}
+
+// CreateProjectRequest holds the arguments of CreateProject
+type CreateProjectRequest struct {
+	OrgID   string
+	Options *CreateProjectOptions
+}
+
+// DeleteProjectRequest holds the arguments of DeleteProject
+type DeleteProjectRequest struct {
+	OrgID     string
+	ProjectID string
+}
+
+// ListMembersRequest holds the arguments of ListOrganizationMembers and ListProjectMembers.
+// ProjectID is unused for organization members.
+type ListMembersRequest struct {
+	OrgID     string
+	ProjectID string
+	Options   *ListOptions
+}
+
+// ChangeMemberRequest holds the arguments of the calls that add, update or remove
+// organization and project members. ProjectID is unused for organization members
+// and Role for removals.
+type ChangeMemberRequest struct {
+	OrgID     string
+	ProjectID string
+	Email     string
+	Role      MemberRole
+}
+
+// ListOrganizations lists the organizations the API key has access to
+func (c *Mem0Client) ListOrganizations(ctx context.Context, opts *ListOptions) (*Page[Organization], error) {
+	return runOperation(c, ctx, OpListOrganizations, opts, c.listOrganizations)
+}
+
+func (c *Mem0Client) listOrganizations(ctx context.Context, opts *ListOptions) (*Page[Organization], error) {
+	page, err := listPage[Organization](ctx, c, c.orgsURL()+"/", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 listed organizations", slog.Int("count", len(page.Results)))
+	return page, nil
+}
+
+// ListProjects lists the projects of an organization. An empty orgID uses the configured organization.
+func (c *Mem0Client) ListProjects(ctx context.Context, orgID string, opts *ListOptions) (*Page[Project], error) {
+	return runOperation(c, ctx, OpListProjects, &ListProjectsRequest{OrgID: orgID, Options: opts}, c.listProjects)
+}
+
+func (c *Mem0Client) listProjects(ctx context.Context, r *ListProjectsRequest) (*Page[Project], error) {
+	if r == nil {
+		return nil, fmt.Errorf("list projects request cannot be nil")
+	}
+
+	orgURL, err := c.organizationURL(ctx, r.OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := listPage[Project](ctx, c, orgURL+"projects/", r.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 listed projects", slog.Int("count", len(page.Results)))
+	return page, nil
+}
+
+// CreateProject creates a project in an organization. An empty orgID uses the configured organization.
+func (c *Mem0Client) CreateProject(ctx context.Context, orgID string, opts *CreateProjectOptions) (*Project, error) {
+	return runOperation(c, ctx, OpCreateProject, &CreateProjectRequest{OrgID: orgID, Options: opts}, c.createProject)
+}
+
+func (c *Mem0Client) createProject(ctx context.Context, r *CreateProjectRequest) (*Project, error) {
+	if r == nil || r.Options == nil || r.Options.Name == "" {
+		return nil, fmt.Errorf("name is required for creating a project")
+	}
+
+	orgURL, err := c.organizationURL(ctx, r.OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var project Project
+	if _, err := c.doJSON(ctx, "POST", orgURL+"projects/", r.Options, &project); err != nil {
+		return nil, err
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 created project", slog.String("project_id", project.ID))
+	return &project, nil
+}
+
+// DeleteProject deletes a project and all of its memories. An empty orgID uses the configured organization.
+func (c *Mem0Client) DeleteProject(ctx context.Context, orgID, projectID string) error {
+	return runErrOperation(c, ctx, OpDeleteProject, &DeleteProjectRequest{OrgID: orgID, ProjectID: projectID}, c.deleteProject)
+}
+
+func (c *Mem0Client) deleteProject(ctx context.Context, r *DeleteProjectRequest) error {
+	if r == nil {
+		return fmt.Errorf("delete project request cannot be nil")
+	}
+
+	projectURL, err := c.organizationProjectURL(ctx, r.OrgID, r.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.doJSON(ctx, "DELETE", projectURL, nil, nil); err != nil {
+		return err
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 deleted project", slog.String("project_id", r.ProjectID))
+	return nil
+}
+
+// ListOrganizationMembers lists the members of an organization. An empty orgID uses the configured organization.
+func (c *Mem0Client) ListOrganizationMembers(ctx context.Context, orgID string, opts *ListOptions) (*Page[Member], error) {
+	return runOperation(c, ctx, OpListOrganizationMembers, &ListMembersRequest{OrgID: orgID, Options: opts}, c.listOrganizationMembers)
+}
+
+// AddOrganizationMember invites a user to an organization with the given role
+func (c *Mem0Client) AddOrganizationMember(ctx context.Context, orgID, email string, role MemberRole) error {
+	return runErrOperation(c, ctx, OpAddOrganizationMember, &ChangeMemberRequest{OrgID: orgID, Email: email, Role: role}, c.changeOrganizationMember("POST"))
+}
+
+// UpdateOrganizationMember changes the role of an organization member
+func (c *Mem0Client) UpdateOrganizationMember(ctx context.Context, orgID, email string, role MemberRole) error {
+	return runErrOperation(c, ctx, OpUpdateOrganizationMember, &ChangeMemberRequest{OrgID: orgID, Email: email, Role: role}, c.changeOrganizationMember("PUT"))
+}
+
+// RemoveOrganizationMember removes a user from an organization
+func (c *Mem0Client) RemoveOrganizationMember(ctx context.Context, orgID, email string) error {
+	return runErrOperation(c, ctx, OpRemoveOrganizationMember, &ChangeMemberRequest{OrgID: orgID, Email: email}, c.changeOrganizationMember("DELETE"))
+}
+
+// ListProjectMembers lists the members of a project. An empty orgID or projectID
+// uses the configured organization or project.
+func (c *Mem0Client) ListProjectMembers(ctx context.Context, orgID, projectID string, opts *ListOptions) (*Page[Member], error) {
+	return runOperation(c, ctx, OpListProjectMembers, &ListMembersRequest{OrgID: orgID, ProjectID: projectID, Options: opts}, c.listProjectMembers)
+}
+
+// AddProjectMember adds a user to a project with the given role
+func (c *Mem0Client) AddProjectMember(ctx context.Context, orgID, projectID, email string, role MemberRole) error {
+	return runErrOperation(c, ctx, OpAddProjectMember, &ChangeMemberRequest{OrgID: orgID, ProjectID: projectID, Email: email, Role: role}, c.changeProjectMember("POST"))
+}
+
+// UpdateProjectMember changes the role of a project member
+func (c *Mem0Client) UpdateProjectMember(ctx context.Context, orgID, projectID, email string, role MemberRole) error {
+	return runErrOperation(c, ctx, OpUpdateProjectMember, &ChangeMemberRequest{OrgID: orgID, ProjectID: projectID, Email: email, Role: role}, c.changeProjectMember("PUT"))
+}
+
+// RemoveProjectMember removes a user from a project
+func (c *Mem0Client) RemoveProjectMember(ctx context.Context, orgID, projectID, email string) error {
+	return runErrOperation(c, ctx, OpRemoveProjectMember, &ChangeMemberRequest{OrgID: orgID, ProjectID: projectID, Email: email}, c.changeProjectMember("DELETE"))
+}
+
+func (c *Mem0Client) listOrganizationMembers(ctx context.Context, r *ListMembersRequest) (*Page[Member], error) {
+	if r == nil {
+		return nil, fmt.Errorf("list members request cannot be nil")
+	}
+	orgURL, err := c.organizationURL(ctx, r.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return c.listMembers(ctx, orgURL+"members/", r.Options)
+}
+
+func (c *Mem0Client) listProjectMembers(ctx context.Context, r *ListMembersRequest) (*Page[Member], error) {
+	if r == nil {
+		return nil, fmt.Errorf("list members request cannot be nil")
+	}
+	projectURL, err := c.organizationProjectURL(ctx, r.OrgID, r.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	return c.listMembers(ctx, projectURL+"members/", r.Options)
+}
+
+// changeOrganizationMember returns the operation that sends a organization member change with method
+func (c *Mem0Client) changeOrganizationMember(method string) func(context.Context, *ChangeMemberRequest) error {
+	return func(ctx context.Context, r *ChangeMemberRequest) error {
+		if r == nil {
+			return fmt.Errorf("change member request cannot be nil")
+		}
+		orgURL, err := c.organizationURL(ctx, r.OrgID)
+		if err != nil {
+			return err
+		}
+		return c.changeMember(ctx, method, orgURL+"members/", r.Email, r.Role)
+	}
+}
+
+// changeProjectMember returns the operation that sends a project member change with method
+func (c *Mem0Client) changeProjectMember(method string) func(context.Context, *ChangeMemberRequest) error {
+	return func(ctx context.Context, r *ChangeMemberRequest) error {
+		if r == nil {
+			return fmt.Errorf("change member request cannot be nil")
+		}
+		projectURL, err := c.organizationProjectURL(ctx, r.OrgID, r.ProjectID)
+		if err != nil {
+			return err
+		}
+		return c.changeMember(ctx, method, projectURL+"members/", r.Email, r.Role)
+	}
+}
+
+func (c *Mem0Client) listMembers(ctx context.Context, reqURL string, opts *ListOptions) (*Page[Member], error) {
+	page, err := listPage[Member](ctx, c, reqURL, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 listed members", slog.Int("count", len(page.Results)))
+	return page, nil
+}
+
+// changeMember adds (POST), updates (PUT) or removes (DELETE) a member
+func (c *Mem0Client) changeMember(ctx context.Context, method, reqURL, email string, role MemberRole) error {
+	c.log(ctx, slog.LevelDebug, "mem0 changing member", slog.String("method", method), slog.String("role", string(role)))
+
+	if email == "" {
+		return fmt.Errorf("email is required")
+	}
+	if method != "DELETE" && role == "" {
+		return fmt.Errorf("role is required")
+	}
+
+	if _, err := c.doJSON(ctx, method, reqURL, memberRequest{Email: email, Role: role}, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *Mem0Client) orgsURL() string {
+	return c.rootURL() + "/api/v1/orgs/organizations"
+}
+
+// organizationURL returns the endpoint of an organization, falling back to the
+// context override and then the configured organization
+func (c *Mem0Client) organizationURL(ctx context.Context, orgID string) (string, error) {
+	orgID, _ = c.resolveScope(ctx, orgID, "")
+	if orgID == "" {
+		return "", fmt.Errorf("organization id is required; pass one or use WithOrganizationID")
+	}
+	return fmt.Sprintf("%s/%s/", c.orgsURL(), url.PathEscape(orgID)), nil
+}
+
+// organizationProjectURL returns the endpoint of a project, falling back to the
+// context override and then the configured organization and project
+func (c *Mem0Client) organizationProjectURL(ctx context.Context, orgID, projectID string) (string, error) {
+	orgURL, err := c.organizationURL(ctx, orgID)
+	if err != nil {
+		return "", err
+	}
+	_, projectID = c.resolveScope(ctx, "", projectID)
+	if projectID == "" {
+		return "", fmt.Errorf("project id is required; pass one or use WithProjectID")
+	}
+	return fmt.Sprintf("%sprojects/%s/", orgURL, url.PathEscape(projectID)), nil
+}
+
+// listPage fetches a single page from a list endpoint that returns either a bare
+// list or a {"results": [...], "count": ..., "next": ...} object
+func listPage[T any](ctx context.Context, c *Mem0Client, reqURL string, opts *ListOptions) (*Page[T], error) {
+	q := url.Values{}
+	if opts != nil {
+		if opts.Page > 0 {
+			q.Add("page", fmt.Sprintf("%d", opts.Page))
+		}
+		if opts.PageSize > 0 {
+			q.Add("page_size", fmt.Sprintf("%d", opts.PageSize))
+		}
+	}
+	if len(q) > 0 {
+		reqURL += "?" + q.Encode()
+	}
+
+	var raw json.RawMessage
+	if _, err := c.doJSON(ctx, "GET", reqURL, nil, &raw); err != nil {
+		return nil, err
+	}
+
+	page := &Page[T]{}
+	if err := json.Unmarshal(raw, &page.Results); err == nil {
+		page.Count = len(page.Results)
+		return page, nil
+	}
+
+	if err := json.Unmarshal(raw, page); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %v", err)
+	}
+	return page, nil
+}
@@ -0,0 +1,16 @@
+module github.com/matigumma/mem0-go-client/mem0client/oteltracer
+
+go 1.23.0
+
+require (
+	github.com/matigumma/mem0-go-client v0.0.0-20261016234414-b166a91ceaf2
+	go.opentelemetry.io/otel v1.38.0
+	go.opentelemetry.io/otel/trace v1.38.0
+)
+
+require (
+	github.com/go-logr/logr v1.4.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
+	go.opentelemetry.io/otel/metric v1.38.0 // indirect
+)
@@ -0,0 +1,97 @@
+// Package oteltracer adapts an OpenTelemetry tracer to the mem0client.Tracer interface
+package oteltracer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/matigumma/mem0-go-client/mem0client"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName names the tracer obtained from the global provider
+const instrumentationName = "github.com/matigumma/mem0-go-client/mem0client"
+
+// Tracer creates OpenTelemetry client spans named "mem0.<operation>"
+type Tracer struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// Option configures a Tracer
+type Option func(*Tracer)
+
+// WithTracerProvider uses provider instead of the global tracer provider
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(t *Tracer) {
+		t.tracer = provider.Tracer(instrumentationName)
+	}
+}
+
+// WithPropagator uses propagator instead of the global text map propagator
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(t *Tracer) {
+		t.propagator = propagator
+	}
+}
+
+// New creates a Tracer backed by the global OpenTelemetry tracer provider and propagator
+func New(opts ...Option) *Tracer {
+	t := &Tracer{}
+	for _, opt := range opts {
+		opt(t)
+	}
+	if t.tracer == nil {
+		t.tracer = otel.Tracer(instrumentationName)
+	}
+	if t.propagator == nil {
+		t.propagator = otel.GetTextMapPropagator()
+	}
+	return t
+}
+
+// Start implements mem0client.Tracer
+func (t *Tracer) Start(ctx context.Context, operation string) (context.Context, mem0client.Span) {
+	ctx, s := t.tracer.Start(ctx, "mem0."+operation, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, &span{span: s}
+}
+
+// Inject implements mem0client.Tracer
+func (t *Tracer) Inject(ctx context.Context, header http.Header) {
+	t.propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+type span struct {
+	span trace.Span
+}
+
+func (s *span) SetAttributes(attrs ...mem0client.Attribute) {
+	kvs := make([]attribute.KeyValue, 0, len(attrs))
+	for _, a := range attrs {
+		switch v := a.Value.(type) {
+		case string:
+			kvs = append(kvs, attribute.String(a.Key, v))
+		case int:
+			kvs = append(kvs, attribute.Int(a.Key, v))
+		case bool:
+			kvs = append(kvs, attribute.Bool(a.Key, v))
+		default:
+			kvs = append(kvs, attribute.String(a.Key, fmt.Sprint(v)))
+		}
+	}
+	s.span.SetAttributes(kvs...)
+}
+
+func (s *span) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+func (s *span) End() {
+	s.span.End()
+}
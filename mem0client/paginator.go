@@ -0,0 +1,135 @@
+package mem0client
+
+import (
+	"context"
+	"iter"
+	"log/slog"
+)
+
+// defaultPageSize matches the page size the API uses when none is given
+const defaultPageSize = 100
+
+// MemoryPaginator walks every page of GetMemories until the result set is exhausted.
+// It can be consumed with Next/Memory/Err or as an iterator through All.
+// A MemoryPaginator is not safe for concurrent use.
+type MemoryPaginator struct {
+	client   *Mem0Client
+	opts     GetMemoriesOptions
+	maxItems int
+
+	page    []ResponseGetMemories
+	index   int
+	current ResponseGetMemories
+	total   int
+	seen    int
+	done    bool
+	err     error
+}
+
+// NewMemoryPaginator creates a paginator over the memories matching opts. Paging starts
+// at opts.Page (or the first page) and uses opts.PageSize (or the API default).
+// maxItems caps the number of memories returned; zero or less means no cap.
+func (c *Mem0Client) NewMemoryPaginator(opts *GetMemoriesOptions, maxItems int) *MemoryPaginator {
+	p := &MemoryPaginator{client: c, maxItems: maxItems, total: -1}
+	if opts != nil {
+		p.opts = *opts
+	}
+	if p.opts.Page <= 0 {
+		p.opts.Page = 1
+	}
+	if p.opts.PageSize <= 0 {
+		p.opts.PageSize = defaultPageSize
+	}
+	return p
+}
+
+// Next advances to the next memory, fetching the next page when needed.
+// It returns false when the result set is exhausted, the cap is reached or an error occurred.
+func (p *MemoryPaginator) Next(ctx context.Context) bool {
+	if p.err != nil || (p.maxItems > 0 && p.seen >= p.maxItems) {
+		return false
+	}
+
+	for p.index >= len(p.page) {
+		if p.done {
+			return false
+		}
+		if err := ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+		if err := p.fetch(ctx); err != nil {
+			p.err = err
+			return false
+		}
+	}
+
+	p.current = p.page[p.index]
+	p.index++
+	p.seen++
+	return true
+}
+
+// fetch loads the next page and marks the paginator done when no further pages exist.
+// Pages are fetched as GetMemories operations in the v1.1 format, which reports the total.
+func (p *MemoryPaginator) fetch(ctx context.Context) error {
+	count := -1
+	opts := p.opts
+	memories, err := runOperation(p.client, ctx, OpGetMemories, &opts, func(ctx context.Context, o *GetMemoriesOptions) ([]ResponseGetMemories, error) {
+		page, err := p.client.getMemoriesPage(ctx, o, OutputFormatV11)
+		if err != nil {
+			return nil, err
+		}
+		count = page.Count
+		return page.Memories, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	p.client.log(ctx, slog.LevelDebug, "mem0 paginator fetched page", slog.Int("page", p.opts.Page), slog.Int("count", len(memories)))
+
+	p.page = memories
+	p.index = 0
+	if count >= 0 {
+		p.total = count
+	}
+
+	fetched := (p.opts.Page-1)*p.opts.PageSize + len(memories)
+	if len(memories) < p.opts.PageSize || (p.total >= 0 && fetched >= p.total) {
+		p.done = true
+	}
+	p.opts.Page++
+	return nil
+}
+
+// Memory returns the memory Next advanced to
+func (p *MemoryPaginator) Memory() ResponseGetMemories {
+	return p.current
+}
+
+// Err returns the error that stopped the paginator, if any
+func (p *MemoryPaginator) Err() error {
+	return p.err
+}
+
+// Total returns the total number of matching memories reported by the API,
+// or -1 when no page has been fetched yet or the API did not report a count
+func (p *MemoryPaginator) Total() int {
+	return p.total
+}
+
+// All returns an iterator over the remaining memories. Iteration stops after
+// yielding the first error, which is also available from Err.
+func (p *MemoryPaginator) All(ctx context.Context) iter.Seq2[ResponseGetMemories, error] {
+	return func(yield func(ResponseGetMemories, error) bool) {
+		for p.Next(ctx) {
+			if !yield(p.current, nil) {
+				return
+			}
+		}
+		if p.err != nil {
+			yield(ResponseGetMemories{}, p.err)
+		}
+	}
+}
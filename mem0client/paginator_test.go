@@ -0,0 +1,61 @@
+package mem0client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestMemoryPaginator(t *testing.T) {
+	const total = 5
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if got := q.Get("output_format"); got != OutputFormatV11 {
+			t.Errorf("output_format = %q, want %q", got, OutputFormatV11)
+		}
+		page, _ := strconv.Atoi(q.Get("page"))
+		size, _ := strconv.Atoi(q.Get("page_size"))
+
+		var results []ResponseGetMemories
+		for i := (page - 1) * size; i < page*size && i < total; i++ {
+			results = append(results, ResponseGetMemories{ID: "mem-" + strconv.Itoa(i)})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"results": results, "count": total})
+	}))
+	defer srv.Close()
+
+	var ops []string
+	record := func(next Handler) Handler {
+		return func(ctx context.Context, op *Operation) (interface{}, error) {
+			ops = append(ops, op.Name)
+			return next(ctx, op)
+		}
+	}
+	c := NewMem0Client("test-key", WithBaseURL(srv.URL), WithMiddleware(record))
+
+	p := c.NewMemoryPaginator(&GetMemoriesOptions{UserID: "alex", PageSize: 2}, 0)
+	if got := p.Total(); got != -1 {
+		t.Errorf("Total() before the first page = %d, want -1", got)
+	}
+
+	var ids []string
+	for m, err := range p.All(context.Background()) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, m.ID)
+	}
+
+	if len(ids) != total || ids[0] != "mem-0" || ids[total-1] != "mem-4" {
+		t.Errorf("paginator returned %v, want mem-0 to mem-4", ids)
+	}
+	if got := p.Total(); got != total {
+		t.Errorf("Total() = %d, want %d", got, total)
+	}
+	if len(ops) != 3 || ops[0] != OpGetMemories {
+		t.Errorf("middleware saw %v, want one GetMemories operation per page", ops)
+	}
+}
@@ -0,0 +1,147 @@
+package mem0client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// CustomCategory is a project level category the extraction pipeline tags memories with
+type CustomCategory struct {
+	Name        string
+	Description string
+}
+
+// MarshalJSON serializes the category in the API's {"<name>": "<description>"} form
+func (c CustomCategory) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{c.Name: c.Description})
+}
+
+// UnmarshalJSON decodes the API's {"<name>": "<description>"} form
+func (c *CustomCategory) UnmarshalJSON(data []byte) error {
+	var m map[string]string
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	if len(m) != 1 {
+		return fmt.Errorf("invalid custom category: expected a single name, got %d", len(m))
+	}
+	for name, description := range m {
+		c.Name = name
+		c.Description = description
+	}
+	return nil
+}
+
+// RetrievalCriterion weighs search results by how well they match a description
+type RetrievalCriterion struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	Weight      float64 `json:"weight"`
+}
+
+// Project holds the settings of a Mem0 project
+type Project struct {
+	ID                 string               `json:"id"`
+	ProjectID          string               `json:"project_id,omitempty"`
+	Name               string               `json:"name"`
+	Description        string               `json:"description,omitempty"`
+	CustomInstructions string               `json:"custom_instructions"`
+	CustomCategories   []CustomCategory     `json:"custom_categories"`
+	RetrievalCriteria  []RetrievalCriterion `json:"retrieval_criteria"`
+	EnableGraph        bool                 `json:"enable_graph"`
+	CreatedAt          time.Time            `json:"created_at"`
+	UpdatedAt          time.Time            `json:"updated_at"`
+}
+
+// UpdateProjectOptions represents the project settings to change. Nil fields are
+// left untouched; an empty, non-nil slice clears the categories or criteria.
+type UpdateProjectOptions struct {
+	CustomInstructions *string              `json:"custom_instructions,omitempty"`
+	CustomCategories   []CustomCategory     `json:"custom_categories"`
+	RetrievalCriteria  []RetrievalCriterion `json:"retrieval_criteria"`
+	EnableGraph        *bool                `json:"enable_graph,omitempty"`
+}
+
+// MarshalJSON leaves out nil fields and sends empty, non-nil slices as []
+func (o UpdateProjectOptions) MarshalJSON() ([]byte, error) {
+	payload := map[string]interface{}{}
+	if o.CustomInstructions != nil {
+		payload["custom_instructions"] = *o.CustomInstructions
+	}
+	if o.CustomCategories != nil {
+		payload["custom_categories"] = o.CustomCategories
+	}
+	if o.RetrievalCriteria != nil {
+		payload["retrieval_criteria"] = o.RetrievalCriteria
+	}
+	if o.EnableGraph != nil {
+		payload["enable_graph"] = *o.EnableGraph
+	}
+	return json.Marshal(payload)
+}
+
+// GetProject retrieves the settings of the configured project, or of the one set with ContextWithProject
+func (c *Mem0Client) GetProject(ctx context.Context) (*Project, error) {
+	return runOperation(c, ctx, OpGetProject, nil, func(ctx context.Context, _ interface{}) (*Project, error) {
+		return c.getProject(ctx)
+	})
+}
+
+func (c *Mem0Client) getProject(ctx context.Context) (*Project, error) {
+	reqURL, err := c.organizationProjectURL(ctx, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var project Project
+	if _, err := c.doJSON(ctx, "GET", reqURL, nil, &project); err != nil {
+		return nil, err
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 got project", slog.String("project_id", project.ID))
+	return &project, nil
+}
+
+// UpdateProject changes the settings of the configured project and returns the updated project
+func (c *Mem0Client) UpdateProject(ctx context.Context, opts *UpdateProjectOptions) (*Project, error) {
+	return runOperation(c, ctx, OpUpdateProject, opts, c.updateProject)
+}
+
+func (c *Mem0Client) updateProject(ctx context.Context, opts *UpdateProjectOptions) (*Project, error) {
+	if opts == nil || (opts.CustomInstructions == nil && opts.CustomCategories == nil &&
+		opts.RetrievalCriteria == nil && opts.EnableGraph == nil) {
+		return nil, fmt.Errorf("at least one project setting is required")
+	}
+
+	for _, cat := range opts.CustomCategories {
+		if cat.Name == "" {
+			return nil, fmt.Errorf("custom category name is required")
+		}
+	}
+	for _, rc := range opts.RetrievalCriteria {
+		if rc.Name == "" {
+			return nil, fmt.Errorf("retrieval criterion name is required")
+		}
+	}
+
+	reqURL, err := c.organizationProjectURL(ctx, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	var project Project
+	if _, err := c.doJSON(ctx, "PATCH", reqURL, opts, &project); err != nil {
+		return nil, err
+	}
+
+	// The API may only acknowledge the update, so read the settings back
+	if project.ID == "" {
+		return c.getProject(ctx)
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 updated project", slog.String("project_id", project.ID))
+	return &project, nil
+}
@@ -0,0 +1,32 @@
+package mem0client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUpdateProjectOptionsJSON(t *testing.T) {
+	enable := false
+	tests := []struct {
+		name string
+		opts UpdateProjectOptions
+		want string
+	}{
+		{"nil fields untouched", UpdateProjectOptions{EnableGraph: &enable}, `{"enable_graph":false}`},
+		{"empty slices clear", UpdateProjectOptions{CustomCategories: []CustomCategory{}, RetrievalCriteria: []RetrievalCriterion{}},
+			`{"custom_categories":[],"retrieval_criteria":[]}`},
+		{"categories set", UpdateProjectOptions{CustomCategories: []CustomCategory{{Name: "food", Description: "Food preferences"}}},
+			`{"custom_categories":[{"food":"Food preferences"}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(&tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package mem0client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrRateLimitExceeded is returned by fail-fast rate limiters when no request budget is left
+var ErrRateLimitExceeded = errors.New("mem0: client rate limit exceeded")
+
+// RateLimit configures a token bucket. A zero RequestsPerSecond disables the bucket.
+type RateLimit struct {
+	RequestsPerSecond float64
+	// Burst is the number of requests that may be sent at once; it defaults to 1
+	Burst int
+}
+
+// RateLimiterOptions configures a RateLimiter. Every request takes a token from the
+// overall budget and from the read or write budget it belongs to; zero budgets are unlimited.
+type RateLimiterOptions struct {
+	// Overall is shared by every request
+	Overall RateLimit
+	// Read applies to searches and lists
+	Read RateLimit
+	// Write applies to adds, updates and deletes
+	Write RateLimit
+	// FailFast returns ErrRateLimitExceeded instead of waiting for a token
+	FailFast bool
+}
+
+// RateLimiter is a client-side token bucket limiter. It is safe for concurrent use
+// and can be shared by several clients that use the same API key.
+type RateLimiter struct {
+	overall  *tokenBucket
+	read     *tokenBucket
+	write    *tokenBucket
+	failFast bool
+}
+
+// NewRateLimiter creates a RateLimiter
+func NewRateLimiter(opts RateLimiterOptions) *RateLimiter {
+	return &RateLimiter{
+		overall:  newTokenBucket(opts.Overall),
+		read:     newTokenBucket(opts.Read),
+		write:    newTokenBucket(opts.Write),
+		failFast: opts.FailFast,
+	}
+}
+
+// WithRateLimiter throttles every request through limiter. Pass the same limiter
+// to several clients to make them share one budget.
+func WithRateLimiter(limiter *RateLimiter) func(*Mem0ClientConfig) {
+	return func(c *Mem0ClientConfig) {
+		c.RateLimiter = limiter
+	}
+}
+
+// Wait takes a token for a read or write request, blocking until one is available,
+// ctx is done, or, in fail-fast mode, returning ErrRateLimitExceeded right away
+func (l *RateLimiter) Wait(ctx context.Context, read bool) error {
+	class := l.write
+	if read {
+		class = l.read
+	}
+	buckets := []*tokenBucket{l.overall, class}
+
+	if l.failFast {
+		now := time.Now()
+		for i, b := range buckets {
+			if !b.tryTake(now) {
+				for _, taken := range buckets[:i] {
+					taken.refund()
+				}
+				return ErrRateLimitExceeded
+			}
+		}
+		return nil
+	}
+
+	now := time.Now()
+	var wait time.Duration
+	for _, b := range buckets {
+		if d := b.reserve(now); d > wait {
+			wait = d
+		}
+	}
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		for _, b := range buckets {
+			b.refund()
+		}
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// tokenBucket refills at rate tokens per second up to burst. Reservations may
+// drive the balance negative, which makes later callers wait their turn.
+// A nil bucket is unlimited.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	if limit.RequestsPerSecond <= 0 {
+		return nil
+	}
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{rate: limit.RequestsPerSecond, burst: burst, tokens: burst, last: time.Now()}
+}
+
+// refill adds the tokens accrued since the last update; callers hold mu
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+		b.last = now
+	}
+}
+
+// tryTake takes a token only if one is available now
+func (b *tokenBucket) tryTake(now time.Time) bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// reserve takes a token and returns how long the caller must wait before using it
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	if b == nil {
+		return 0
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.refill(now)
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// refund returns a token that was taken but not used
+func (b *tokenBucket) refund() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens++
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
@@ -0,0 +1,106 @@
+package mem0client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// slowRate refills so slowly that no token comes back during a test
+const slowRate = 0.001
+
+func tokens(b *tokenBucket) float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.tokens
+}
+
+func TestRateLimiterFailFast(t *testing.T) {
+	l := NewRateLimiter(RateLimiterOptions{
+		Overall:  RateLimit{RequestsPerSecond: slowRate, Burst: 3},
+		Read:     RateLimit{RequestsPerSecond: slowRate, Burst: 1},
+		FailFast: true,
+	})
+	ctx := context.Background()
+
+	if err := l.Wait(ctx, true); err != nil {
+		t.Fatalf("first read: %v", err)
+	}
+	if err := l.Wait(ctx, true); !errors.Is(err, ErrRateLimitExceeded) {
+		t.Fatalf("second read = %v, want ErrRateLimitExceeded", err)
+	}
+	// The rejected read gave its overall token back, so two writes still fit
+	for i := 0; i < 2; i++ {
+		if err := l.Wait(ctx, false); err != nil {
+			t.Fatalf("write %d: %v", i+1, err)
+		}
+	}
+	if err := l.Wait(ctx, false); !errors.Is(err, ErrRateLimitExceeded) {
+		t.Errorf("write over the overall budget = %v, want ErrRateLimitExceeded", err)
+	}
+}
+
+func TestRateLimiterRefundsCancelledWait(t *testing.T) {
+	l := NewRateLimiter(RateLimiterOptions{
+		Overall: RateLimit{RequestsPerSecond: slowRate, Burst: 5},
+		Write:   RateLimit{RequestsPerSecond: slowRate, Burst: 1},
+	})
+
+	if err := l.Wait(context.Background(), false); err != nil {
+		t.Fatal(err)
+	}
+	before := tokens(l.overall)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx, false); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Wait() = %v, want the write to block until ctx is done", err)
+	}
+
+	if got := tokens(l.overall); got < before-0.01 {
+		t.Errorf("overall tokens = %.2f after a cancelled wait, want %.2f refunded", got, before)
+	}
+	if got := tokens(l.write); got < -0.01 {
+		t.Errorf("write tokens = %.2f after a cancelled wait, want the reservation refunded", got)
+	}
+}
+
+func TestRateLimiterWaitsForToken(t *testing.T) {
+	l := NewRateLimiter(RateLimiterOptions{Overall: RateLimit{RequestsPerSecond: 20, Burst: 1}})
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(context.Background(), true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("3 requests at 20/s with a burst of 1 took %s, want about 100ms", elapsed)
+	}
+}
+
+func TestRateLimitedClientFailsFast(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(`{"id": "mem-1"}`))
+	}))
+	defer srv.Close()
+
+	limiter := NewRateLimiter(RateLimiterOptions{Overall: RateLimit{RequestsPerSecond: slowRate, Burst: 1}, FailFast: true})
+	c := NewMem0Client("test-key", WithBaseURL(srv.URL), WithRateLimiter(limiter), WithRetryPolicy(fastRetries))
+
+	if _, err := c.GetMemory(context.Background(), "mem-1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetMemory(context.Background(), "mem-1"); !errors.Is(err, ErrRateLimitExceeded) {
+		t.Errorf("GetMemory() = %v, want ErrRateLimitExceeded", err)
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("server saw %d requests, want the limited one not to be sent or retried", got)
+	}
+}
@@ -0,0 +1,265 @@
+package mem0client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptrace"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// IdempotencyKeyHeader carries the idempotency key of a write request. Writes
+// that are not naturally idempotent are only retried when it is set.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// RetryPolicy controls how failed requests are retried
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 2 disable retries.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry; it doubles on every attempt
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff delay. Retry-After headers are honored even when longer.
+	MaxDelay time.Duration
+	// Jitter is the fraction of the delay, between 0 and 1, that is randomized
+	Jitter float64
+	// RetryableStatusCodes lists the HTTP status codes that are retried
+	RetryableStatusCodes []int
+}
+
+// DefaultRetryPolicy returns a policy that retries rate limiting, gateway errors
+// and connection failures up to three times
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:          4,
+		BaseDelay:            500 * time.Millisecond,
+		MaxDelay:             10 * time.Second,
+		Jitter:               0.5,
+		RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+	}
+}
+
+// WithRetryPolicy enables automatic retries with exponential backoff
+func WithRetryPolicy(policy RetryPolicy) func(*Mem0ClientConfig) {
+	return func(c *Mem0ClientConfig) {
+		c.RetryPolicy = &policy
+	}
+}
+
+func (p *RetryPolicy) retryableStatus(code int) bool {
+	for _, c := range p.RetryableStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the given retry, starting at 1
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < retry && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	jitter := p.Jitter
+	if jitter > 1 {
+		jitter = 1
+	}
+	if jitter > 0 && delay > 0 {
+		spread := time.Duration(float64(delay) * jitter)
+		delay = delay - spread + time.Duration(rand.Int64N(int64(spread)+1))
+	}
+	return delay
+}
+
+type readOnlyContextKey struct{}
+
+type idempotencyKeyContextKey struct{}
+
+// markReadOnly flags requests made with ctx as reads even though they use POST,
+// e.g. searches. They are safe to retry and count against the read budget.
+func markReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyContextKey{}, true)
+}
+
+// isReadRequest reports whether req only reads data
+func isReadRequest(req *http.Request) bool {
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS":
+		return true
+	}
+	marked, _ := req.Context().Value(readOnlyContextKey{}).(bool)
+	return marked
+}
+
+// canRetryAfterSend reports whether req may be resent once it reached the server
+func canRetryAfterSend(req *http.Request) bool {
+	if req.Method == "PUT" || req.Method == "DELETE" || isReadRequest(req) {
+		return true
+	}
+	return req.Header.Get(IdempotencyKeyHeader) != ""
+}
+
+// do sends req, retrying according to the configured retry policy
+func (c *Mem0Client) do(req *http.Request) (*http.Response, error) {
+	policy := c.config.RetryPolicy
+	if policy == nil || policy.MaxAttempts < 2 {
+		return c.send(req)
+	}
+
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 {
+			var err error
+			if attemptReq, err = cloneRequest(req); err != nil {
+				return nil, err
+			}
+		}
+
+		// Track whether the request was written so failures before sending can always be retried.
+		// The transport may report it from its write goroutine after RoundTrip returned.
+		var wrote atomic.Bool
+		trace := &httptrace.ClientTrace{
+			WroteRequest: func(httptrace.WroteRequestInfo) { wrote.Store(true) },
+		}
+		attemptReq = attemptReq.WithContext(httptrace.WithClientTrace(attemptReq.Context(), trace))
+
+		resp, err := c.send(attemptReq)
+		if errors.Is(err, ErrRateLimitExceeded) || errors.Is(err, ErrCircuitOpen) {
+			return nil, err
+		}
+
+		var retryAfter time.Duration
+		switch {
+		case err != nil:
+			if ctx.Err() != nil || attempt >= policy.MaxAttempts || (wrote.Load() && !canRetryAfterSend(req)) {
+				return nil, err
+			}
+			c.log(ctx, slog.LevelWarn, "mem0 request failed, retrying", slog.Int("attempt", attempt), slog.String("method", req.Method), slog.String("path", req.URL.Path), c.errorAttr(err))
+		case policy.retryableStatus(resp.StatusCode):
+			if attempt >= policy.MaxAttempts || !canRetryAfterSend(req) {
+				return resp, nil
+			}
+			retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+			c.log(ctx, slog.LevelWarn, "mem0 request failed, retrying", slog.Int("attempt", attempt), slog.String("method", req.Method), slog.String("path", req.URL.Path), slog.Int("status", resp.StatusCode))
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		default:
+			return resp, nil
+		}
+
+		if c.config.Metrics != nil {
+			c.config.Metrics.addRetry(operationName(ctx))
+		}
+
+		delay := policy.backoff(attempt)
+		if retryAfter > delay {
+			delay = retryAfter
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// send sends a single attempt of req through the circuit breaker and rate limiter, if any,
+// with the trace context of the request in its headers
+func (c *Mem0Client) send(req *http.Request) (*http.Response, error) {
+	breaker := c.config.CircuitBreaker
+	var generation uint64
+	if breaker != nil {
+		var err error
+		if generation, err = breaker.allow(); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.config.RateLimiter != nil {
+		if err := c.config.RateLimiter.Wait(req.Context(), isReadRequest(req)); err != nil {
+			if breaker != nil {
+				// The request never reached the API, so it says nothing about its health
+				breaker.release(generation)
+			}
+			return nil, err
+		}
+	}
+
+	if c.config.Tracer != nil {
+		c.config.Tracer.Inject(req.Context(), req.Header)
+	}
+
+	start := time.Now()
+	resp, err := c.config.HTTPClient.Do(req)
+	if breaker != nil {
+		breaker.record(generation, resp, err)
+	}
+	if metrics := c.config.Metrics; metrics != nil {
+		op := operationName(req.Context())
+		metrics.addRequestBytes(op, req.ContentLength)
+		if err == nil {
+			if state := operationOf(req.Context()); state != nil {
+				state.status.Store(int32(resp.StatusCode))
+			}
+			resp.Body = &countingBody{ReadCloser: resp.Body, onRead: func(n int64) { metrics.addResponseBytes(op, n) }}
+		}
+	}
+
+	if ctx := req.Context(); c.logEnabled(ctx, slog.LevelDebug) {
+		attrs := []any{slog.String("method", req.Method), slog.String("path", req.URL.Path), slog.Duration("duration", time.Since(start))}
+		if err != nil {
+			attrs = append(attrs, c.errorAttr(err))
+		} else {
+			attrs = append(attrs, slog.Int("status", resp.StatusCode))
+		}
+		c.log(ctx, slog.LevelDebug, "mem0 request", attrs...)
+	}
+	return resp, err
+}
+
+// cloneRequest copies req with a fresh body for another attempt
+func cloneRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, fmt.Errorf("request body cannot be replayed for retry")
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay request body: %v", err)
+		}
+		clone.Body = body
+	}
+	return clone, nil
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
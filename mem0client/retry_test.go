@@ -0,0 +1,115 @@
+package mem0client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fastRetries retries quickly so tests only wait when the server asks them to
+var fastRetries = RetryPolicy{
+	MaxAttempts:          3,
+	BaseDelay:            time.Millisecond,
+	MaxDelay:             time.Millisecond,
+	RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+}
+
+// flakyServer fails the first failures requests with status and then succeeds with body
+func flakyServer(t *testing.T, failures int32, status int, header http.Header, body string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &attempts
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	srv, attempts := flakyServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"1"}}, `{"id": "mem-1"}`)
+	c := NewMem0Client("test-key", WithBaseURL(srv.URL), WithRetryPolicy(fastRetries))
+
+	start := time.Now()
+	if _, err := c.GetMemory(context.Background(), "mem-1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("attempts = %d, want 2", got)
+	}
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Errorf("retried after %s, want the 1s Retry-After to override the 1ms backoff", elapsed)
+	}
+}
+
+func TestRetryStoreRequiresIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name         string
+		key          string
+		wantAttempts int32
+	}{
+		{"without key", "", 1},
+		{"with key", "store-1", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, attempts := flakyServer(t, 1, http.StatusServiceUnavailable, nil, `{"results": []}`)
+			c := NewMem0Client("test-key", WithBaseURL(srv.URL), WithRetryPolicy(fastRetries))
+
+			_, err := c.Store(context.Background(), &StoreOptions{
+				UserID:         "alex",
+				Messages:       []Message{{Role: "user", Content: "hi"}},
+				IdempotencyKey: tt.key,
+			})
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, tt.wantAttempts)
+			}
+			if tt.key == "" && !errors.Is(err, ErrServer) {
+				t.Errorf("Store() error = %v, want the 503 without a retry", err)
+			}
+			if tt.key != "" && err != nil {
+				t.Errorf("Store() error = %v, want the retry to succeed", err)
+			}
+		})
+	}
+}
+
+func TestRetryStopsWhenContextCancelledDuringBackoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		// Cancel once the client has the response and is backing off
+		time.AfterFunc(50*time.Millisecond, cancel)
+	}))
+	defer srv.Close()
+
+	policy := fastRetries
+	policy.BaseDelay, policy.MaxDelay = time.Minute, time.Minute
+	c := NewMem0Client("test-key", WithBaseURL(srv.URL), WithRetryPolicy(policy))
+
+	start := time.Now()
+	_, err := c.GetMemory(ctx, "mem-1")
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("GetMemory() error = %v, want context.Canceled", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("returned after %s, want the backoff to stop on cancellation", elapsed)
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Errorf("attempts = %d, want 1", got)
+	}
+}
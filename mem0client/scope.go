@@ -0,0 +1,79 @@
+package mem0client
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+type scopeContextKey struct{}
+
+type scope struct {
+	orgID     string
+	projectID string
+}
+
+// ContextWithProject returns a context that makes every call made with it target
+// the given organization and project instead of the ones configured on the client.
+// Empty values fall back to the client configuration. Per-call option fields such
+// as OrgID and ProjectID still take precedence.
+func ContextWithProject(ctx context.Context, orgID, projectID string) context.Context {
+	return context.WithValue(ctx, scopeContextKey{}, scope{orgID: orgID, projectID: projectID})
+}
+
+// resolveScope returns the organization and project a call should target: the
+// per-call values when set, then the context override, then the client configuration
+func (c *Mem0Client) resolveScope(ctx context.Context, orgID, projectID string) (string, string) {
+	override, _ := ctx.Value(scopeContextKey{}).(scope)
+
+	if orgID == "" {
+		orgID = override.orgID
+	}
+	if orgID == "" {
+		orgID = c.config.OrganizationID
+	}
+	if projectID == "" {
+		projectID = override.projectID
+	}
+	if projectID == "" {
+		projectID = c.config.ProjectID
+	}
+	return orgID, projectID
+}
+
+// scopeQuery adds org_id and project_id to q unless they are already present
+func (c *Mem0Client) scopeQuery(ctx context.Context, q url.Values) {
+	orgID, projectID := c.resolveScope(ctx, q.Get("org_id"), q.Get("project_id"))
+	if orgID != "" {
+		q.Set("org_id", orgID)
+	}
+	if projectID != "" {
+		q.Set("project_id", projectID)
+	}
+}
+
+// scopedURL appends the org_id and project_id query parameters to reqURL
+func (c *Mem0Client) scopedURL(ctx context.Context, reqURL string) string {
+	q := url.Values{}
+	c.scopeQuery(ctx, q)
+	if len(q) == 0 {
+		return reqURL
+	}
+	if strings.Contains(reqURL, "?") {
+		return reqURL + "&" + q.Encode()
+	}
+	return reqURL + "?" + q.Encode()
+}
+
+// scopePayload adds org_id and project_id to a map payload unless they are already present
+func (c *Mem0Client) scopePayload(ctx context.Context, payload map[string]interface{}) {
+	orgID, _ := payload["org_id"].(string)
+	projectID, _ := payload["project_id"].(string)
+	orgID, projectID = c.resolveScope(ctx, orgID, projectID)
+	if orgID != "" {
+		payload["org_id"] = orgID
+	}
+	if projectID != "" {
+		payload["project_id"] = projectID
+	}
+}
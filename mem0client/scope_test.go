@@ -0,0 +1,209 @@
+package mem0client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// scopeRecorder is a fake API that records the org and project of every request,
+// from the query string or the JSON body
+type scopeRecorder struct {
+	mu       sync.Mutex
+	requests []recordedScope
+}
+
+type recordedScope struct {
+	method, path       string
+	queryOrg, queryPrj string
+	bodyOrg, bodyPrj   string
+}
+
+func (s *scopeRecorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rec := recordedScope{
+		method:   r.Method,
+		path:     r.URL.EscapedPath(),
+		queryOrg: r.URL.Query().Get("org_id"),
+		queryPrj: r.URL.Query().Get("project_id"),
+	}
+	body, _ := io.ReadAll(r.Body)
+	var payload struct {
+		OrgID     string `json:"org_id"`
+		ProjectID string `json:"project_id"`
+	}
+	if json.Unmarshal(body, &payload) == nil {
+		rec.bodyOrg, rec.bodyPrj = payload.OrgID, payload.ProjectID
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, rec)
+	s.mu.Unlock()
+
+	switch {
+	case r.URL.Path == "/memories/" && r.Method == "POST":
+		w.Write([]byte(`{"results": []}`))
+	case r.URL.Path == "/memories/" || (r.URL.Path == "/feedback/" && r.Method == "GET"):
+		w.Write([]byte(`[]`))
+	default:
+		w.Write([]byte(`{}`))
+	}
+}
+
+func (s *scopeRecorder) last(t *testing.T) recordedScope {
+	t.Helper()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.requests) == 0 {
+		t.Fatal("no request was sent")
+	}
+	return s.requests[len(s.requests)-1]
+}
+
+func newScopeTestClient(t *testing.T) (*Mem0Client, *scopeRecorder) {
+	t.Helper()
+	rec := &scopeRecorder{}
+	srv := httptest.NewServer(rec)
+	t.Cleanup(srv.Close)
+	return NewMem0Client("test-key", WithBaseURL(srv.URL), WithOrganizationID("org-default"), WithProjectID("prj-default")), rec
+}
+
+func TestScopePrecedenceBody(t *testing.T) {
+	c, rec := newScopeTestClient(t)
+	perCallOrg, perCallPrj := "org-call", "prj-call"
+
+	tests := []struct {
+		name             string
+		ctx              context.Context
+		orgID, projectID *string
+		wantOrg, wantPrj string
+	}{
+		{"client default", context.Background(), nil, nil, "org-default", "prj-default"},
+		{"context override", ContextWithProject(context.Background(), "org-ctx", "prj-ctx"), nil, nil, "org-ctx", "prj-ctx"},
+		{"per-call value", ContextWithProject(context.Background(), "org-ctx", "prj-ctx"), &perCallOrg, &perCallPrj, "org-call", "prj-call"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.Store(tt.ctx, &StoreOptions{
+				UserID:         "alex",
+				Messages:       []Message{{Role: "user", Content: "hi"}},
+				OrganizationID: tt.orgID,
+				ProjectID:      tt.projectID,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := rec.last(t)
+			if got.bodyOrg != tt.wantOrg || got.bodyPrj != tt.wantPrj {
+				t.Errorf("sent org %q project %q, want %q %q", got.bodyOrg, got.bodyPrj, tt.wantOrg, tt.wantPrj)
+			}
+		})
+	}
+}
+
+func TestScopePrecedenceQuery(t *testing.T) {
+	c, rec := newScopeTestClient(t)
+
+	tests := []struct {
+		name             string
+		ctx              context.Context
+		opts             *GetMemoriesOptions
+		wantOrg, wantPrj string
+	}{
+		{"client default", context.Background(), &GetMemoriesOptions{UserID: "alex"}, "org-default", "prj-default"},
+		{"context override", ContextWithProject(context.Background(), "org-ctx", "prj-ctx"), &GetMemoriesOptions{UserID: "alex"}, "org-ctx", "prj-ctx"},
+		{"per-call value", ContextWithProject(context.Background(), "org-ctx", "prj-ctx"), &GetMemoriesOptions{UserID: "alex", OrgID: "org-call", ProjectID: "prj-call"}, "org-call", "prj-call"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := c.GetMemories(tt.ctx, tt.opts); err != nil {
+				t.Fatal(err)
+			}
+			got := rec.last(t)
+			if got.queryOrg != tt.wantOrg || got.queryPrj != tt.wantPrj {
+				t.Errorf("sent org %q project %q, want %q %q", got.queryOrg, got.queryPrj, tt.wantOrg, tt.wantPrj)
+			}
+		})
+	}
+}
+
+func TestStoreOptionsReusedAcrossTenants(t *testing.T) {
+	c, rec := newScopeTestClient(t)
+	opts := &StoreOptions{UserID: "alex", Messages: []Message{{Role: "user", Content: "hi"}}}
+
+	for _, tenant := range []string{"tenant-a", "tenant-b", ""} {
+		ctx := context.Background()
+		want := "prj-default"
+		if tenant != "" {
+			ctx = ContextWithProject(ctx, "", tenant)
+			want = tenant
+		}
+		if _, err := c.Store(ctx, opts); err != nil {
+			t.Fatal(err)
+		}
+		if got := rec.last(t).bodyPrj; got != want {
+			t.Errorf("tenant %q: sent project %q, want %q", tenant, got, want)
+		}
+	}
+	if opts.ProjectID != nil || opts.OrganizationID != nil || opts.OutputFormat != nil || opts.Metadata != nil {
+		t.Errorf("Store modified the caller's options: %+v", opts)
+	}
+}
+
+func TestScopeAppliedToEveryCall(t *testing.T) {
+	c, rec := newScopeTestClient(t)
+	ctx := ContextWithProject(context.Background(), "org-ctx", "prj-ctx")
+
+	tests := []struct {
+		name   string
+		call   func() error
+		inBody bool
+	}{
+		{"UpdateMemory", func() error {
+			_, err := c.UpdateMemory(ctx, "mem/1", &UpdateMemoryOptions{Text: "new"})
+			return err
+		}, false},
+		{"SubmitFeedback", func() error {
+			_, err := c.SubmitFeedback(ctx, "mem-1", FeedbackPositive, "")
+			return err
+		}, true},
+		{"ListFeedback", func() error {
+			_, err := c.ListFeedback(ctx, "mem-1")
+			return err
+		}, false},
+		{"BatchUpdate", func() error {
+			_, err := c.BatchUpdate(ctx, []BatchUpdateItem{{MemoryID: "mem-1", Text: "new"}})
+			return err
+		}, false},
+		{"BatchDelete", func() error {
+			_, err := c.BatchDelete(ctx, []string{"mem-1"})
+			return err
+		}, false},
+		{"GetEvent", func() error {
+			_, err := c.GetEvent(ctx, "evt-1")
+			return err
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); err != nil {
+				t.Fatal(err)
+			}
+			got := rec.last(t)
+			org, prj := got.queryOrg, got.queryPrj
+			if tt.inBody {
+				org, prj = got.bodyOrg, got.bodyPrj
+			}
+			if org != "org-ctx" || prj != "prj-ctx" {
+				t.Errorf("%s %s sent org %q project %q, want org-ctx prj-ctx", got.method, got.path, org, prj)
+			}
+		})
+	}
+
+	if got := rec.requests[0].path; got != "/memories/mem%2F1/" {
+		t.Errorf("UpdateMemory sent path %q, want the memory ID escaped", got)
+	}
+}
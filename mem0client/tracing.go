@@ -0,0 +1,123 @@
+package mem0client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+)
+
+// Span attribute keys set by the client
+const (
+	AttrOperation   = "mem0.operation"
+	AttrUserIDHash  = "mem0.user_id_hash"
+	AttrAgentIDHash = "mem0.agent_id_hash"
+	AttrAppIDHash   = "mem0.app_id_hash"
+	AttrRunIDHash   = "mem0.run_id_hash"
+	AttrTopK        = "mem0.top_k"
+	AttrRerank      = "mem0.rerank"
+	AttrResultCount = "mem0.result_count"
+	AttrErrorCode   = "mem0.error_code"
+)
+
+// Attribute is a span attribute. Value is a string, int or bool.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Tracer creates spans around client operations and propagates trace context
+// to the API. See the oteltracer package for an OpenTelemetry adapter.
+type Tracer interface {
+	// Start starts a span for the named operation, e.g. OpStore, and returns a
+	// context carrying it
+	Start(ctx context.Context, operation string) (context.Context, Span)
+	// Inject writes the trace context carried by ctx into outgoing request headers
+	Inject(ctx context.Context, header http.Header)
+}
+
+// Span is a single traced operation
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// WithTracer traces every client call with tracer
+func WithTracer(tracer Tracer) func(*Mem0ClientConfig) {
+	return func(c *Mem0ClientConfig) {
+		c.Tracer = tracer
+	}
+}
+
+// hashID hashes an entity ID so spans can be correlated without exposing it
+func hashID(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(sum[:8])
+}
+
+// operationAttributes returns the span attributes describing an operation and its options
+func operationAttributes(name string, opts interface{}) []Attribute {
+	attrs := []Attribute{{Key: AttrOperation, Value: name}}
+
+	entities := func(userID, agentID, appID, runID string) {
+		for _, e := range []struct{ key, id string }{
+			{AttrUserIDHash, userID},
+			{AttrAgentIDHash, agentID},
+			{AttrAppIDHash, appID},
+			{AttrRunIDHash, runID},
+		} {
+			if e.id != "" {
+				attrs = append(attrs, Attribute{Key: e.key, Value: hashID(e.id)})
+			}
+		}
+	}
+
+	switch o := opts.(type) {
+	case *StoreOptions:
+		if o != nil {
+			entities(o.UserID, o.AgentID, derefString(o.AppID), o.RunID)
+		}
+	case *GetMemoriesOptions:
+		if o != nil {
+			entities(o.UserID, o.AgentID, o.AppID, o.RunID)
+		}
+	case *SearchMemoriesOptions:
+		if o != nil {
+			entities(o.UserID, o.AgentID, o.AppID, o.RunID)
+			attrs = append(attrs, Attribute{Key: AttrTopK, Value: o.TopK}, Attribute{Key: AttrRerank, Value: o.Rerank})
+		}
+	case *SearchMemoriesV2Options:
+		if o != nil {
+			attrs = append(attrs, Attribute{Key: AttrTopK, Value: o.TopK}, Attribute{Key: AttrRerank, Value: o.Rerank})
+		}
+	case *UpdateMemoryRequest:
+		if o != nil && o.Options != nil {
+			entities(o.Options.UserID, o.Options.AgentID, o.Options.AppID, "")
+		}
+	case *DeleteAllOptions:
+		if o != nil {
+			entities(o.UserID, o.AgentID, o.AppID, o.RunID)
+		}
+	case *GetRelationsOptions:
+		if o != nil {
+			entities(o.UserID, o.AgentID, o.AppID, o.RunID)
+		}
+	}
+	return attrs
+}
+
+// endSpan records the outcome of an operation on span and ends it
+func endSpan(span Span, result interface{}, err error) {
+	if err != nil {
+		var apiErr *Mem0Error
+		if errors.As(err, &apiErr) && apiErr.Code != "" {
+			span.SetAttributes(Attribute{Key: AttrErrorCode, Value: apiErr.Code})
+		}
+		span.RecordError(err)
+	} else if n, ok := resultCount(result); ok {
+		span.SetAttributes(Attribute{Key: AttrResultCount, Value: n})
+	}
+	span.End()
+}
@@ -0,0 +1,20 @@
+package mem0client
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStoreSpanAttributes(t *testing.T) {
+	appID := "app-1"
+	got := operationAttributes(OpStore, &StoreOptions{UserID: "alex", AppID: &appID})
+
+	want := []Attribute{
+		{Key: AttrOperation, Value: OpStore},
+		{Key: AttrUserIDHash, Value: hashID("alex")},
+		{Key: AttrAppIDHash, Value: hashID("app-1")},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("operationAttributes() = %v, want %v", got, want)
+	}
+}
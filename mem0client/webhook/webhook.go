@@ -0,0 +1,254 @@
+// Package webhook receives Mem0 webhook deliveries, verifies them and dispatches
+// typed events to registered callbacks.
+//
+//	h := webhook.NewHandler(webhook.WithSecret(secret))
+//	h.OnMemoryAdd(func(ctx context.Context, e *webhook.Event) error {
+//		log.Printf("memory %s added: %s", e.MemoryID, e.Memory)
+//		return nil
+//	})
+//	http.Handle("/mem0/webhook", h)
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/matigumma/mem0-go-client/mem0client"
+)
+
+// SignatureHeader carries the hex encoded HMAC-SHA256 of the delivery body
+const SignatureHeader = "X-Mem0-Signature"
+
+// defaultMaxBodyBytes bounds the size of a delivery body
+const defaultMaxBodyBytes = 1 << 20
+
+// Event is a decoded webhook delivery
+type Event struct {
+	Type           mem0client.WebhookEventType
+	MemoryID       string
+	Memory         string
+	PreviousMemory *string
+	UserID         string
+	AgentID        string
+	RunID          string
+	Metadata       mem0client.Metadata
+	// Raw holds the full delivery body
+	Raw json.RawMessage
+}
+
+// HandlerFunc handles a single event. Returning an error responds with a 500 so the delivery is retried.
+type HandlerFunc func(ctx context.Context, event *Event) error
+
+// Handler is an http.Handler that verifies and dispatches webhook deliveries.
+// Callbacks may be registered concurrently with serving requests.
+type Handler struct {
+	secret       []byte
+	skipVerify   bool
+	maxBodyBytes int64
+
+	mu       sync.RWMutex
+	handlers map[mem0client.WebhookEventType][]HandlerFunc
+}
+
+// Option configures a Handler
+type Option func(*Handler)
+
+// WithSecret requires every delivery to carry a valid SignatureHeader computed with secret
+func WithSecret(secret string) Option {
+	return func(h *Handler) {
+		h.secret = []byte(secret)
+	}
+}
+
+// WithoutVerification accepts deliveries without checking their signature. Only use
+// it when the endpoint is authenticated some other way, e.g. on a private network.
+func WithoutVerification() Option {
+	return func(h *Handler) {
+		h.skipVerify = true
+	}
+}
+
+// WithMaxBodyBytes limits the size of accepted delivery bodies
+func WithMaxBodyBytes(n int64) Option {
+	return func(h *Handler) {
+		h.maxBodyBytes = n
+	}
+}
+
+// NewHandler creates a Handler with no callbacks registered. Unless WithSecret or
+// WithoutVerification is given it rejects every delivery with a 500, so a missing
+// secret is noticed rather than silently accepting forged events.
+func NewHandler(opts ...Option) *Handler {
+	h := &Handler{
+		maxBodyBytes: defaultMaxBodyBytes,
+		handlers:     make(map[mem0client.WebhookEventType][]HandlerFunc),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// On registers fn for events of the given type. Callbacks run in registration order.
+func (h *Handler) On(eventType mem0client.WebhookEventType, fn HandlerFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handlers[eventType] = append(h.handlers[eventType], fn)
+}
+
+// OnMemoryAdd registers fn for memory_add events
+func (h *Handler) OnMemoryAdd(fn HandlerFunc) { h.On(mem0client.WebhookEventMemoryAdd, fn) }
+
+// OnMemoryUpdate registers fn for memory_update events
+func (h *Handler) OnMemoryUpdate(fn HandlerFunc) { h.On(mem0client.WebhookEventMemoryUpdate, fn) }
+
+// OnMemoryDelete registers fn for memory_delete events
+func (h *Handler) OnMemoryDelete(fn HandlerFunc) { h.On(mem0client.WebhookEventMemoryDelete, fn) }
+
+// ServeHTTP verifies the delivery, decodes it and runs the callbacks registered for its type
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, h.maxBodyBytes+1))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	if int64(len(body)) > h.maxBodyBytes {
+		http.Error(w, "body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if len(h.secret) == 0 && !h.skipVerify {
+		http.Error(w, "webhook secret not configured", http.StatusInternalServerError)
+		return
+	}
+	if err := h.verify(r.Header.Get(SignatureHeader), body); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	event, err := Decode(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.mu.RLock()
+	handlers := h.handlers[event.Type]
+	h.mu.RUnlock()
+
+	for _, fn := range handlers {
+		if err := fn(r.Context(), event); err != nil {
+			http.Error(w, "handler failed", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// verify checks the signature of body unless verification is disabled
+func (h *Handler) verify(signature string, body []byte) error {
+	if h.skipVerify {
+		return nil
+	}
+	if signature == "" {
+		return fmt.Errorf("missing %s header", SignatureHeader)
+	}
+
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return fmt.Errorf("malformed %s header", SignatureHeader)
+	}
+
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+// Sign returns the SignatureHeader value for body, for testing receivers and forwarding deliveries
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Decode parses a delivery body. Deliveries carry either an explicit "event_type"
+// or an "event_details" object whose ADD/UPDATE/DELETE event maps to the type.
+func Decode(body []byte) (*Event, error) {
+	var wire struct {
+		EventType    mem0client.WebhookEventType `json:"event_type"`
+		EventDetails *struct {
+			ID             string                 `json:"id"`
+			Event          mem0client.MemoryEvent `json:"event"`
+			Memory         string                 `json:"memory"`
+			PreviousMemory *string                `json:"previous_memory"`
+			Data           *struct {
+				Memory    string  `json:"memory"`
+				OldMemory *string `json:"old_memory"`
+			} `json:"data"`
+			UserID   string              `json:"user_id"`
+			AgentID  string              `json:"agent_id"`
+			RunID    string              `json:"run_id"`
+			Metadata mem0client.Metadata `json:"metadata"`
+		} `json:"event_details"`
+	}
+	if err := json.Unmarshal(body, &wire); err != nil {
+		return nil, fmt.Errorf("failed to decode delivery: %v", err)
+	}
+	if wire.EventDetails == nil {
+		return nil, fmt.Errorf("failed to decode delivery: missing event_details")
+	}
+
+	d := wire.EventDetails
+	event := &Event{
+		Type:           wire.EventType,
+		MemoryID:       d.ID,
+		Memory:         d.Memory,
+		PreviousMemory: d.PreviousMemory,
+		UserID:         d.UserID,
+		AgentID:        d.AgentID,
+		RunID:          d.RunID,
+		Metadata:       d.Metadata,
+		Raw:            json.RawMessage(body),
+	}
+	if d.Data != nil {
+		if event.Memory == "" {
+			event.Memory = d.Data.Memory
+		}
+		if event.PreviousMemory == nil {
+			event.PreviousMemory = d.Data.OldMemory
+		}
+	}
+
+	if event.Type == "" {
+		switch d.Event {
+		case mem0client.MemoryEventAdd:
+			event.Type = mem0client.WebhookEventMemoryAdd
+		case mem0client.MemoryEventUpdate:
+			event.Type = mem0client.WebhookEventMemoryUpdate
+		case mem0client.MemoryEventDelete:
+			event.Type = mem0client.WebhookEventMemoryDelete
+		default:
+			return nil, fmt.Errorf("failed to decode delivery: unknown event %q", d.Event)
+		}
+	}
+
+	return event, nil
+}
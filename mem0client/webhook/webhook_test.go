@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/matigumma/mem0-go-client/mem0client"
+)
+
+const testSecret = "whsec-test"
+
+const addDelivery = `{"event_details": {"id": "mem-1", "event": "ADD", "data": {"memory": "Likes tea"}, "user_id": "alex"}}`
+
+func deliver(h http.Handler, body, signature string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/mem0/webhook", strings.NewReader(body))
+	if signature != "" {
+		req.Header.Set(SignatureHeader, signature)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+func TestHandlerSignatures(t *testing.T) {
+	tests := []struct {
+		name       string
+		opts       []Option
+		signature  string
+		wantStatus int
+		wantCalled bool
+	}{
+		{"valid signature", []Option{WithSecret(testSecret)}, Sign(testSecret, []byte(addDelivery)), http.StatusNoContent, true},
+		{"signature without prefix", []Option{WithSecret(testSecret)}, strings.TrimPrefix(Sign(testSecret, []byte(addDelivery)), "sha256="), http.StatusNoContent, true},
+		{"wrong secret", []Option{WithSecret(testSecret)}, Sign("other", []byte(addDelivery)), http.StatusUnauthorized, false},
+		{"malformed signature", []Option{WithSecret(testSecret)}, "sha256=zz", http.StatusUnauthorized, false},
+		{"missing signature", []Option{WithSecret(testSecret)}, "", http.StatusUnauthorized, false},
+		{"no secret configured", nil, "", http.StatusInternalServerError, false},
+		{"empty secret", []Option{WithSecret("")}, Sign("", []byte(addDelivery)), http.StatusInternalServerError, false},
+		{"verification disabled", []Option{WithoutVerification()}, "", http.StatusNoContent, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHandler(tt.opts...)
+			var called bool
+			h.OnMemoryAdd(func(ctx context.Context, e *Event) error {
+				called = true
+				return nil
+			})
+
+			w := deliver(h, addDelivery, tt.signature)
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d (%s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if called != tt.wantCalled {
+				t.Errorf("callback called = %v, want %v", called, tt.wantCalled)
+			}
+		})
+	}
+}
+
+func TestHandlerDispatch(t *testing.T) {
+	h := NewHandler(WithSecret(testSecret))
+
+	var got []string
+	h.OnMemoryAdd(func(ctx context.Context, e *Event) error {
+		got = append(got, "add:"+e.MemoryID+":"+e.Memory+":"+e.UserID)
+		return nil
+	})
+	h.OnMemoryAdd(func(ctx context.Context, e *Event) error {
+		got = append(got, "add-second")
+		return nil
+	})
+	h.OnMemoryUpdate(func(ctx context.Context, e *Event) error {
+		got = append(got, "update:"+e.Memory+":"+*e.PreviousMemory)
+		return nil
+	})
+	h.OnMemoryDelete(func(ctx context.Context, e *Event) error {
+		return errors.New("downstream unavailable")
+	})
+
+	send := func(body string) int {
+		return deliver(h, body, Sign(testSecret, []byte(body))).Code
+	}
+
+	if code := send(addDelivery); code != http.StatusNoContent {
+		t.Fatalf("add delivery status = %d", code)
+	}
+	if code := send(`{"event_type": "memory_update", "event_details": {"id": "mem-1", "memory": "Likes coffee", "previous_memory": "Likes tea"}}`); code != http.StatusNoContent {
+		t.Fatalf("update delivery status = %d", code)
+	}
+	want := []string{"add:mem-1:Likes tea:alex", "add-second", "update:Likes coffee:Likes tea"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("callbacks ran %v, want %v", got, want)
+	}
+
+	if code := send(`{"event_details": {"id": "mem-1", "event": "DELETE"}}`); code != http.StatusInternalServerError {
+		t.Errorf("failing callback status = %d, want 500 so the delivery is retried", code)
+	}
+	if code := send(`{"event_details": {"id": "mem-1", "event": "EXPLODE"}}`); code != http.StatusBadRequest {
+		t.Errorf("unknown event status = %d, want 400", code)
+	}
+	if code := send(`{"event_type": "` + string(mem0client.WebhookEventMemoryAdd) + `"}`); code != http.StatusBadRequest {
+		t.Errorf("delivery without details status = %d, want 400", code)
+	}
+}
+
+func TestHandlerRejectsOtherMethods(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/mem0/webhook", nil)
+	w := httptest.NewRecorder()
+	NewHandler(WithSecret(testSecret)).ServeHTTP(w, req)
+	if w.Code != http.StatusMethodNotAllowed || w.Header().Get("Allow") != http.MethodPost {
+		t.Errorf("GET status = %d Allow = %q, want 405 POST", w.Code, w.Header().Get("Allow"))
+	}
+}
@@ -0,0 +1,190 @@
+package mem0client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+)
+
+// WebhookEventType is a memory change a webhook can subscribe to
+type WebhookEventType string
+
+const (
+	WebhookEventMemoryAdd    WebhookEventType = "memory_add"
+	WebhookEventMemoryUpdate WebhookEventType = "memory_update"
+	WebhookEventMemoryDelete WebhookEventType = "memory_delete"
+)
+
+// Webhook is a project level subscription to memory changes
+type Webhook struct {
+	ID         string             `json:"webhook_id"`
+	Name       string             `json:"name"`
+	URL        string             `json:"url"`
+	EventTypes []WebhookEventType `json:"event_types"`
+	IsActive   bool               `json:"is_active"`
+	Project    string             `json:"project,omitempty"`
+	// Secret is used to sign deliveries when the API provides one
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// WebhookOptions represents the options for creating or updating a webhook
+type WebhookOptions struct {
+	Name       string             `json:"name,omitempty"`
+	URL        string             `json:"url,omitempty"`
+	EventTypes []WebhookEventType `json:"event_types,omitempty"`
+	// IsActive is only used on update; nil leaves the current state untouched
+	IsActive *bool `json:"is_active,omitempty"`
+}
+
+func (o *WebhookOptions) validateEventTypes() error {
+	for _, t := range o.EventTypes {
+		switch t {
+		case WebhookEventMemoryAdd, WebhookEventMemoryUpdate, WebhookEventMemoryDelete:
+		default:
+			return fmt.Errorf("invalid webhook event type: %s", t)
+		}
+	}
+	return nil
+}
+
+// CreateWebhookRequest holds the arguments of CreateWebhook
+type CreateWebhookRequest struct {
+	ProjectID string
+	Options   *WebhookOptions
+}
+
+// UpdateWebhookRequest holds the arguments of UpdateWebhook
+type UpdateWebhookRequest struct {
+	WebhookID string
+	Options   *WebhookOptions
+}
+
+// CreateWebhook creates a webhook on a project. An empty projectID uses the configured project.
+func (c *Mem0Client) CreateWebhook(ctx context.Context, projectID string, opts *WebhookOptions) (*Webhook, error) {
+	return runOperation(c, ctx, OpCreateWebhook, &CreateWebhookRequest{ProjectID: projectID, Options: opts}, c.createWebhook)
+}
+
+func (c *Mem0Client) createWebhook(ctx context.Context, r *CreateWebhookRequest) (*Webhook, error) {
+	if r == nil {
+		return nil, fmt.Errorf("create webhook request cannot be nil")
+	}
+	projectID, opts := r.ProjectID, r.Options
+
+	if opts == nil || opts.URL == "" || opts.Name == "" {
+		return nil, fmt.Errorf("name and url are required for creating a webhook")
+	}
+	if len(opts.EventTypes) == 0 {
+		return nil, fmt.Errorf("at least one event type is required for creating a webhook")
+	}
+	if err := opts.validateEventTypes(); err != nil {
+		return nil, err
+	}
+
+	reqURL, err := c.projectWebhooksURL(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhook Webhook
+	if _, err := c.doJSON(ctx, "POST", reqURL, opts, &webhook); err != nil {
+		return nil, err
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 created webhook", slog.String("webhook_id", webhook.ID))
+	return &webhook, nil
+}
+
+// ListWebhooks lists the webhooks of a project. An empty projectID uses the configured project.
+func (c *Mem0Client) ListWebhooks(ctx context.Context, projectID string) ([]Webhook, error) {
+	return runOperation(c, ctx, OpListWebhooks, projectID, c.listWebhooks)
+}
+
+func (c *Mem0Client) listWebhooks(ctx context.Context, projectID string) ([]Webhook, error) {
+	reqURL, err := c.projectWebhooksURL(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw json.RawMessage
+	if _, err := c.doJSON(ctx, "GET", reqURL, nil, &raw); err != nil {
+		return nil, err
+	}
+
+	var webhooks []Webhook
+	if err := decodeResultList(raw, &webhooks); err != nil {
+		return nil, err
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 listed webhooks", slog.Int("count", len(webhooks)))
+	return webhooks, nil
+}
+
+// UpdateWebhook changes the name, URL, event types or active state of a webhook
+func (c *Mem0Client) UpdateWebhook(ctx context.Context, webhookID string, opts *WebhookOptions) (*Webhook, error) {
+	return runOperation(c, ctx, OpUpdateWebhook, &UpdateWebhookRequest{WebhookID: webhookID, Options: opts}, c.updateWebhook)
+}
+
+func (c *Mem0Client) updateWebhook(ctx context.Context, r *UpdateWebhookRequest) (*Webhook, error) {
+	if r == nil {
+		return nil, fmt.Errorf("update webhook request cannot be nil")
+	}
+	webhookID, opts := r.WebhookID, r.Options
+
+	if webhookID == "" {
+		return nil, fmt.Errorf("webhook id is required")
+	}
+	if opts == nil || (opts.Name == "" && opts.URL == "" && len(opts.EventTypes) == 0 && opts.IsActive == nil) {
+		return nil, fmt.Errorf("at least one webhook setting is required")
+	}
+	if err := opts.validateEventTypes(); err != nil {
+		return nil, err
+	}
+
+	var webhook Webhook
+	if _, err := c.doJSON(ctx, "PUT", c.webhookURL(webhookID), opts, &webhook); err != nil {
+		return nil, err
+	}
+	if webhook.ID == "" {
+		webhook.ID = webhookID
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 updated webhook", slog.String("webhook_id", webhook.ID))
+	return &webhook, nil
+}
+
+// DeleteWebhook deletes a webhook
+func (c *Mem0Client) DeleteWebhook(ctx context.Context, webhookID string) error {
+	return runErrOperation(c, ctx, OpDeleteWebhook, webhookID, c.deleteWebhook)
+}
+
+func (c *Mem0Client) deleteWebhook(ctx context.Context, webhookID string) error {
+	if webhookID == "" {
+		return fmt.Errorf("webhook id is required")
+	}
+
+	if _, err := c.doJSON(ctx, "DELETE", c.webhookURL(webhookID), nil, nil); err != nil {
+		return err
+	}
+
+	c.log(ctx, slog.LevelDebug, "mem0 deleted webhook", slog.String("webhook_id", webhookID))
+	return nil
+}
+
+// projectWebhooksURL returns the webhooks endpoint of a project, falling back to
+// the context override and then the configured project
+func (c *Mem0Client) projectWebhooksURL(ctx context.Context, projectID string) (string, error) {
+	_, projectID = c.resolveScope(ctx, "", projectID)
+	if projectID == "" {
+		return "", fmt.Errorf("project id is required; pass one or use WithProjectID")
+	}
+	return fmt.Sprintf("%s/api/v1/webhooks/projects/%s/", c.rootURL(), url.PathEscape(projectID)), nil
+}
+
+func (c *Mem0Client) webhookURL(webhookID string) string {
+	return fmt.Sprintf("%s/api/v1/webhooks/%s/", c.rootURL(), url.PathEscape(webhookID))
+}